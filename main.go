@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"flag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst"
+	"io"
 	"log"
+	"os"
+	"strings"
 )
 
 func main() {
@@ -14,19 +17,66 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return spotinst.Provider()
-		},
+	if flag.Arg(0) == "generate" {
+		if err := generate(flag.Args()[1:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
 	}
 
+	ctx := context.Background()
+
+	// The SDKv2 resources are muxed with the terraform-plugin-framework ones
+	// into a single protocol version 5 server.
+	serverFactory, err := spotinst.ProviderServerFactory(ctx)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf5server.ServeOpt
+	providerAddr := "registry.terraform.io/spotinst/spotinst"
 	if debugMode {
-		err := plugin.Debug(context.Background(), "terraform-spotinst/local/spotinst", opts)
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
+		providerAddr = "terraform-spotinst/local/spotinst"
+	}
+
+	if err := tf5server.Serve(providerAddr, serverFactory, serveOpts...); err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// generate writes Terraform configuration and import blocks for the existing
+// resources of a Spotinst account. Credentials are read the same way the
+// provider reads them (SPOTINST_TOKEN/SPOTINST_ACCOUNT or the credentials
+// file) unless given as flags.
+func generate(args []string) error {
+	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	out := fs.String("out", "", "file to write the configuration to (default: stdout)")
+	token := fs.String("token", "", "Spotinst Personal API Access Token")
+	account := fs.String("account", "", "Spotinst Account ID")
+	resources := fs.String("resources", "", fmt.Sprintf("comma-separated resource types to generate (default: %s)",
+		strings.Join(spotinst.GenerateResourceTypes(), ",")))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	opts := &spotinst.GenerateOptions{
+		Token:   *token,
+		Account: *account,
+	}
+	if *resources != "" {
+		opts.ResourceTypes = strings.Split(*resources, ",")
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
 		if err != nil {
-			log.Fatal(err.Error())
+			return err
 		}
-		return
+		defer f.Close()
+		w = f
 	}
 
-	plugin.Serve(opts)
+	return spotinst.Generate(context.Background(), w, opts)
 }
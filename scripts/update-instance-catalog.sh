@@ -0,0 +1,139 @@
+#!/usr/bin/env bash
+
+# Regenerate the instance type catalog embedded in the provider
+# (spotinst/instance_catalog/{aws,gcp,azure}.json) from the cloud provider
+# APIs. Requires jq and authenticated aws, gcloud and az CLIs.
+#
+# The catalog is region-agnostic; types are listed from one reference
+# region/zone per cloud, which can be overridden:
+#
+#   AWS_REGION=us-east-1 GCP_ZONE=us-central1-a AZURE_LOCATION=eastus \
+#     ./scripts/update-instance-catalog.sh [aws|gcp|azure]...
+#
+# Set OUT_DIR to write the files elsewhere, e.g. to a directory referenced by
+# SPOTINST_INSTANCE_CATALOG_DIR.
+
+set -euo pipefail
+
+OUT_DIR=${OUT_DIR:-"$(dirname "$0")/../spotinst/instance_catalog"}
+AWS_REGION=${AWS_REGION:-us-east-1}
+GCP_ZONE=${GCP_ZONE:-us-central1-a}
+AZURE_LOCATION=${AZURE_LOCATION:-eastus}
+UPDATED_AT=$(date -u +%Y-%m-%d)
+
+# write <cloud>: wraps the entries read from stdin, one per line and sorted by
+# name, in the catalog document. The document is marked complete, which turns
+# on the plan-time checks that rely on a type being absent from the catalog.
+write() {
+	local cloud=$1 file="${OUT_DIR}/$1.json"
+	{
+		printf '{\n  "provider": "%s",\n  "updatedAt": "%s",\n  "complete": true,\n  "instanceTypes": [\n' "${cloud}" "${UPDATED_AT}"
+		jq -s -c 'sort_by(.family, .vcpu, .memoryGiB, .name) | .[]' |
+			sed -e 's/^/    /' -e 's/":/": /g' -e 's/,"/, "/g' -e '$!s/$/,/'
+		printf '  ]\n}\n'
+	} >"${file}.tmp"
+	mv "${file}.tmp" "${file}"
+	echo "==> Wrote $(grep -c '"name"' "${file}") ${cloud} instance types to ${file}"
+}
+
+aws_catalog() {
+	echo "==> Listing AWS instance types in ${AWS_REGION}..."
+	aws ec2 describe-instance-types --region "${AWS_REGION}" --output json |
+		jq -c '.InstanceTypes[] | select(.CurrentGeneration) |
+			(.InstanceType | split(".")[0]) as $family |
+			{
+				name: .InstanceType,
+				family: $family,
+				category: (
+					if ($family | test("^(p|g|inf|trn|dl|f|vt)[0-9]")) then "Accelerated_computing"
+					elif ($family | test("^(i|d|h|im|is)[0-9]")) then "Storage_optimized"
+					elif ($family | test("^(r|x|z|u-)")) then "Memory_optimized"
+					elif ($family | test("^c[0-9]")) then "Compute_optimized"
+					else "General_purpose" end),
+				architecture: (if (.ProcessorInfo.SupportedArchitectures | index("arm64")) then "arm64" else "x86_64" end),
+				vcpu: .VCpuInfo.DefaultVCpus,
+				memoryGiB: (.MemoryInfo.SizeInMiB / 1024),
+				gpu: ([.GpuInfo.Gpus[]?.Count] | add),
+				gpuType: (.GpuInfo.Gpus[0]? | if . then "\(.Manufacturer)-\(.Name)" | ascii_downcase else null end),
+				metal: (if .BareMetal then true else null end),
+				hypervisor: .Hypervisor,
+				enaSupported: (.NetworkInfo.EnaSupport != "unsupported")
+			} | with_entries(select(.value != null))' |
+		write aws
+}
+
+gcp_catalog() {
+	echo "==> Listing GCP machine types in ${GCP_ZONE}..."
+	gcloud compute machine-types list --filter="zone:(${GCP_ZONE})" --format=json |
+		jq -c '.[] |
+			(.name | split("-")[0]) as $family |
+			{
+				name: .name,
+				family: $family,
+				category: (
+					if ($family | test("^(a[0-9]|g[0-9])")) then "Accelerator_optimized"
+					elif ($family | test("^(c2|c2d|c3|c3d|h3)$")) then "Compute_optimized"
+					elif ($family | test("^m[0-9]")) then "Memory_optimized"
+					else "General_purpose" end),
+				architecture: (if ($family | test("^(t2a|c4a)$")) then "arm64" else "x86_64" end),
+				vcpu: .guestCpus,
+				memoryGiB: ((.memoryMb / 1024 * 100 | round) / 100),
+				gpu: ([.accelerators[]?.guestAcceleratorCount] | add),
+				gpuType: .accelerators[0]?.guestAcceleratorType
+			} | with_entries(select(.value != null))' |
+		write gcp
+}
+
+azure_catalog() {
+	echo "==> Listing Azure VM sizes in ${AZURE_LOCATION}..."
+	az vm list-skus --location "${AZURE_LOCATION}" --resource-type virtualMachines --output json |
+		jq -c '.[] | select(.restrictions | length == 0) |
+			(.capabilities | map({(.name): .value}) | add) as $c |
+			(.family | sub("^standard"; ""; "i") | sub("Family$"; "") | gsub(" "; "")) as $series |
+			{
+				name: .name,
+				family: $series,
+				category: (
+					if ($series | test("^N"; "i")) then "GPU"
+					elif ($series | test("^H"; "i")) then "highPerformanceCompute"
+					elif ($series | test("^L"; "i")) then "storageOptimized"
+					elif ($series | test("^F"; "i")) then "computeOptimized"
+					elif ($series | test("^(E|M|G)"; "i")) then "memoryOptimized"
+					else "generalPurpose" end),
+				architecture: (
+					if $c.CpuArchitectureType == "Arm64" then "arm64"
+					elif (.name | test("^Standard_[A-Z]+[0-9]+[a-z]*a[a-z]*_")) then "amd64"
+					else "intel64" end),
+				vcpu: ($c.vCPUs | tonumber),
+				memoryGiB: ($c.MemoryGB | tonumber),
+				gpu: ($c.GPUs // null | if . then tonumber else null end),
+				gpuType: (
+					if (.name | test("T4")) then "nvidia-tesla-t4"
+					elif (.name | test("A100")) then "nvidia-tesla-a100"
+					elif (.name | test("H100")) then "nvidia-tesla-h100"
+					elif (.name | test("A10")) then "nvidia-a10"
+					elif (.name | test("^Standard_NC[0-9]+s?_v3$")) then "nvidia-tesla-v100"
+					else null end),
+				acceleratedNetworking: ($c.AcceleratedNetworkingEnabled == "True"),
+				premiumStorage: ($c.PremiumIO == "True"),
+				spot: ($c.LowPriorityCapable == "True")
+			} | with_entries(select(.value != null))' |
+		write azure
+}
+
+clouds=("$@")
+if [[ ${#clouds[@]} -eq 0 ]]; then
+	clouds=(aws gcp azure)
+fi
+
+for cloud in "${clouds[@]}"; do
+	case "${cloud}" in
+	aws) aws_catalog ;;
+	gcp) gcp_catalog ;;
+	azure) azure_catalog ;;
+	*)
+		echo "unknown cloud: ${cloud}" >&2
+		exit 1
+		;;
+	esac
+done
@@ -0,0 +1,29 @@
+package account_onboarding
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	Name           commons.FieldName = "name"
+	CloudAccountID commons.FieldName = "cloud_account_id"
+	OrganizationID commons.FieldName = "organization_id"
+	ExternalID     commons.FieldName = "external_id"
+)
+
+const (
+	AWS     commons.FieldName = "aws"
+	IamRole commons.FieldName = "iam_role"
+)
+
+const (
+	Azure          commons.FieldName = "azure"
+	ClientID       commons.FieldName = "client_id"
+	ClientSecret   commons.FieldName = "client_secret"
+	TenantID       commons.FieldName = "tenant_id"
+	SubscriptionID commons.FieldName = "subscription_id"
+	ExpirationDate commons.FieldName = "expiration_date"
+)
+
+const (
+	GCP               commons.FieldName = "gcp"
+	ServiceAccountKey commons.FieldName = "service_account_key"
+)
@@ -0,0 +1,318 @@
+package account_onboarding
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	commons.RegisterSensitivePaths("clientSecret", "private_key")
+
+	fieldsMap[Name] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		Name,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			var value *string = nil
+			if onboarding.Account != nil && onboarding.Account.Name != nil {
+				value = onboarding.Account.Name
+			}
+			if err := resourceData.Set(string(Name), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Name), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			onboarding.Account.SetName(spotinst.String(resourceData.Get(string(Name)).(string)))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[CloudAccountID] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		CloudAccountID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			var value *string = nil
+			if onboarding.Account != nil && onboarding.Account.AccountId != nil {
+				value = onboarding.Account.AccountId
+			}
+			if err := resourceData.Set(string(CloudAccountID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CloudAccountID), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[OrganizationID] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		OrganizationID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			var value *string = nil
+			if onboarding.Account != nil && onboarding.Account.OrganizationId != nil {
+				value = onboarding.Account.OrganizationId
+			}
+			if err := resourceData.Set(string(OrganizationID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(OrganizationID), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[ExternalID] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		ExternalID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			if err := resourceData.Set(string(ExternalID), onboarding.ExternalID); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ExternalID), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[AWS] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		AWS,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{string(Azure), string(GCP)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(IamRole): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			if onboarding.AWSCredentials == nil {
+				return nil
+			}
+			value := []interface{}{
+				map[string]interface{}{
+					string(IamRole): spotinst.StringValue(onboarding.AWSCredentials.IamRole),
+				},
+			}
+			if err := resourceData.Set(string(AWS), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(AWS), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			onboarding.AWSCredentials = expandAWSCredentials(resourceData.Get(string(AWS)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			onboarding.AWSCredentials = expandAWSCredentials(resourceData.Get(string(AWS)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Azure] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		Azure,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{string(AWS), string(GCP)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ClientID): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(ClientSecret): {
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
+					},
+
+					string(TenantID): {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+
+					string(SubscriptionID): {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+
+					string(ExpirationDate): {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			onboarding.AzureCredentials = expandAzureCredentials(resourceData.Get(string(Azure)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			onboarding.AzureCredentials = expandAzureCredentials(resourceData.Get(string(Azure)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[GCP] = commons.NewGenericField(
+		commons.AccountOnboarding,
+		GCP,
+		&schema.Schema{
+			Type:          schema.TypeList,
+			Optional:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{string(AWS), string(Azure)},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(ServiceAccountKey): {
+						Type:      schema.TypeString,
+						Required:  true,
+						Sensitive: true,
+					},
+				},
+			},
+		},
+		nil,
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			credentials, err := expandGCPCredentials(resourceData.Get(string(GCP)))
+			if err != nil {
+				return err
+			}
+			onboarding.GCPCredentials = credentials
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			onboarding := resourceObject.(*commons.AccountOnboardingWrapper).GetOnboarding()
+			credentials, err := expandGCPCredentials(resourceData.Get(string(GCP)))
+			if err != nil {
+				return err
+			}
+			onboarding.GCPCredentials = credentials
+			return nil
+		},
+		nil,
+	)
+}
+
+// expandAWSCredentials returns nil until an IAM role is configured, which
+// leaves the account waiting for the role that trusts its external ID.
+func expandAWSCredentials(data interface{}) *aws.Credentials {
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	iamRole, ok := m[string(IamRole)].(string)
+	if !ok || iamRole == "" {
+		return nil
+	}
+	credentials := &aws.Credentials{}
+	credentials.SetIamRole(spotinst.String(iamRole))
+	return credentials
+}
+
+func expandAzureCredentials(data interface{}) *azure.Credentials {
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	credentials := &azure.Credentials{}
+	credentials.SetClientId(spotinst.String(m[string(ClientID)].(string)))
+	credentials.SetClientSecret(spotinst.String(m[string(ClientSecret)].(string)))
+	credentials.SetTenantId(spotinst.String(m[string(TenantID)].(string)))
+	credentials.SetSubscriptionId(spotinst.String(m[string(SubscriptionID)].(string)))
+	if v, ok := m[string(ExpirationDate)].(string); ok && v != "" {
+		credentials.SetExpirationDate(spotinst.String(v))
+	}
+	return credentials
+}
+
+// expandGCPCredentials parses a service account key file, whose keys match
+// the fields of the service account credentials.
+func expandGCPCredentials(data interface{}) (*gcp.ServiceAccounts, error) {
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil, nil
+	}
+	m := list[0].(map[string]interface{})
+
+	credentials := &gcp.ServiceAccounts{}
+	if err := json.Unmarshal([]byte(m[string(ServiceAccountKey)].(string)), credentials); err != nil {
+		return nil, fmt.Errorf("%s: invalid service account key: %v", ServiceAccountKey, err)
+	}
+	return credentials, nil
+}
+
+// CustomizeDiff replaces the account when the cloud it is onboarded to
+// changes, i.e. when an aws, azure or gcp block is added or removed.
+// Changes within a block only attach the credentials again.
+func CustomizeDiff(diff *schema.ResourceDiff) error {
+	for _, field := range []commons.FieldName{AWS, Azure, GCP} {
+		if !diff.HasChange(string(field)) {
+			continue
+		}
+		o, n := diff.GetChange(string(field))
+		if len(o.([]interface{})) != len(n.([]interface{})) {
+			if err := diff.ForceNew(string(field)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
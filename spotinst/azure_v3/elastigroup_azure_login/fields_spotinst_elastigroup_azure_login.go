@@ -11,6 +11,8 @@ import (
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("login.password")
+
 	fieldsMap[Login] = commons.NewGenericField(
 		commons.ElastigroupAzureLogin,
 		Login,
@@ -26,8 +28,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Password): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Optional:  true,
 					},
 
 					string(SSHPublicKey): {
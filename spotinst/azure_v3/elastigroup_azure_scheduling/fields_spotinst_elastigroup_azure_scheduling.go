@@ -29,8 +29,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 					string(ScaleMaxCapacity): {
 						Type:     schema.TypeString,
@@ -11,6 +11,8 @@ import (
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("login.password")
+
 	fieldsMap[Login] = commons.NewGenericField(
 		commons.StatefulNodeAzureLogin,
 		Login,
@@ -25,8 +27,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(Password): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Optional:  true,
 					},
 					string(SSHPublicKey): {
 						Type:     schema.TypeString,
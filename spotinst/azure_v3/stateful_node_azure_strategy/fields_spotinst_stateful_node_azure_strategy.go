@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/stateful/providers/azure"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -21,14 +22,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(PreferredLifecycle): {
-						Type:     schema.TypeString,
-						Optional: true,
-						Computed: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice([]string{"od", "spot"}, false),
 					},
 					string(DrainingTimeout): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Computed: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(0),
 					},
 					string(FallbackToOnDemand): {
 						Type:     schema.TypeBool,
@@ -42,8 +45,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(PerformAt): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice([]string{"timeWindow", "never", "always"}, false),
 								},
 							},
 						},
@@ -112,16 +116,23 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					string(OptimizationWindows): {
 						Type:     schema.TypeList,
 						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: commons.ValidateTimeWindow,
+						},
 					},
 					string(OdWindows): {
 						Type:     schema.TypeList,
 						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: commons.ValidateTimeWindow,
+						},
 					},
 					string(AvailabilityVsCost): {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 100),
 					},
 					string(VmAdmins): {
 						Type:     schema.TypeList,
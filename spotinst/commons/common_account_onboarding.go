@@ -0,0 +1,134 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/azure"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/gcp"
+)
+
+const (
+	AccountOnboardingResourceName ResourceName = "spotinst_account_onboarding"
+)
+
+var AccountOnboardingResource *AccountOnboardingTerraformResource
+
+type AccountOnboardingTerraformResource struct {
+	GenericResource
+}
+
+// OnboardedAccount is what spotinst_account_onboarding manages: a Spot
+// account, the external ID of its AWS trust policy and the credentials of
+// one cloud. Only the credentials of the configured cloud are set.
+type OnboardedAccount struct {
+	Account          *aws.Account
+	ExternalID       *string
+	AWSCredentials   *aws.Credentials
+	AzureCredentials *azure.Credentials
+	GCPCredentials   *gcp.ServiceAccounts
+}
+
+type AccountOnboardingWrapper struct {
+	onboarding *OnboardedAccount
+}
+
+func NewAccountOnboardingResource(fieldsMap map[FieldName]*GenericField) *AccountOnboardingTerraformResource {
+	return &AccountOnboardingTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: AccountOnboardingResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *AccountOnboardingTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*OnboardedAccount, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	onboardingWrapper := NewAccountOnboardingWrapper()
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(onboardingWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return onboardingWrapper.GetOnboarding(), nil
+}
+
+func (res *AccountOnboardingTerraformResource) OnRead(
+	onboarding *OnboardedAccount,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	onboardingWrapper := NewAccountOnboardingWrapper()
+	onboardingWrapper.SetOnboarding(onboarding)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(onboardingWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate returns the credentials to attach again, with a bool indicating
+// whether any of them changed.
+func (res *AccountOnboardingTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *OnboardedAccount, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	onboardingWrapper := NewAccountOnboardingWrapper()
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(onboardingWrapper, resourceData, meta); err != nil {
+				return false, nil, err
+			}
+			hasChanged = true
+		}
+	}
+	return hasChanged, onboardingWrapper.GetOnboarding(), nil
+}
+
+func NewAccountOnboardingWrapper() *AccountOnboardingWrapper {
+	return &AccountOnboardingWrapper{
+		onboarding: &OnboardedAccount{
+			Account: &aws.Account{},
+		},
+	}
+}
+
+func (onboardingWrapper *AccountOnboardingWrapper) GetOnboarding() *OnboardedAccount {
+	return onboardingWrapper.onboarding
+}
+
+func (onboardingWrapper *AccountOnboardingWrapper) SetOnboarding(onboarding *OnboardedAccount) {
+	onboardingWrapper.onboarding = onboarding
+}
@@ -0,0 +1,19 @@
+package commons
+
+const (
+	OceanAWSInstanceTypesDataSourceName ResourceName = "spotinst_ocean_aws_instance_types"
+	OceanGKEMachineTypesDataSourceName  ResourceName = "spotinst_ocean_gke_machine_types"
+	OceanAKSVmSizesDataSourceName       ResourceName = "spotinst_ocean_aks_vm_sizes"
+
+	OceanAWSExtendedResourceDefinitionsDataSourceName ResourceName = "spotinst_ocean_aws_extended_resource_definitions"
+)
+
+const (
+	OrganizationPolicyDocumentDataSourceName ResourceName = "spotinst_organization_policy_document"
+	OrganizationUserDataSourceName           ResourceName = "spotinst_organization_user"
+	OrganizationUsersDataSourceName          ResourceName = "spotinst_organization_users"
+	OrganizationUserGroupDataSourceName      ResourceName = "spotinst_organization_user_group"
+	OrganizationUserGroupsDataSourceName     ResourceName = "spotinst_organization_user_groups"
+	OrganizationPolicyDataSourceName         ResourceName = "spotinst_organization_policy"
+	OrganizationPoliciesDataSourceName       ResourceName = "spotinst_organization_policies"
+)
@@ -0,0 +1,93 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSScalingPolicyResourceName ResourceName = "spotinst_elastigroup_aws_scaling_policy"
+)
+
+var ElastigroupAWSScalingPolicyResource *ElastigroupAWSScalingPolicyTerraformResource
+
+type ElastigroupAWSScalingPolicyTerraformResource struct {
+	GenericResource
+}
+
+type ElastigroupAWSScalingPolicyWrapper struct {
+	scaling *aws.Scaling
+}
+
+func NewElastigroupAWSScalingPolicyResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSScalingPolicyTerraformResource {
+	return &ElastigroupAWSScalingPolicyTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSScalingPolicyResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns a new Scaling holding the policy or an error.
+func (res *ElastigroupAWSScalingPolicyTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*aws.Scaling, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	scalingWrapper := NewElastigroupAWSScalingPolicyWrapper()
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(scalingWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return scalingWrapper.GetScaling(), nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if it is unable to do so.
+func (res *ElastigroupAWSScalingPolicyTerraformResource) OnRead(
+	scaling *aws.Scaling,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	scalingWrapper := NewElastigroupAWSScalingPolicyWrapper()
+	scalingWrapper.SetScaling(scaling)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(scalingWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewElastigroupAWSScalingPolicyWrapper() *ElastigroupAWSScalingPolicyWrapper {
+	return &ElastigroupAWSScalingPolicyWrapper{
+		scaling: &aws.Scaling{},
+	}
+}
+
+func (scalingWrapper *ElastigroupAWSScalingPolicyWrapper) GetScaling() *aws.Scaling {
+	return scalingWrapper.scaling
+}
+
+func (scalingWrapper *ElastigroupAWSScalingPolicyWrapper) SetScaling(scaling *aws.Scaling) {
+	scalingWrapper.scaling = scaling
+}
@@ -0,0 +1,125 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+)
+
+const (
+	ElastigroupAWSScheduledTaskResourceName ResourceName = "spotinst_elastigroup_aws_scheduled_task"
+)
+
+var ElastigroupAWSScheduledTaskResource *ElastigroupAWSScheduledTaskTerraformResource
+
+type ElastigroupAWSScheduledTaskTerraformResource struct {
+	GenericResource
+}
+
+type ElastigroupAWSScheduledTaskWrapper struct {
+	task *aws.Task
+}
+
+func NewElastigroupAWSScheduledTaskResource(fieldsMap map[FieldName]*GenericField) *ElastigroupAWSScheduledTaskTerraformResource {
+	return &ElastigroupAWSScheduledTaskTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: ElastigroupAWSScheduledTaskResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns a new Task or an error.
+func (res *ElastigroupAWSScheduledTaskTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*aws.Task, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	taskWrapper := NewElastigroupAWSScheduledTaskWrapper()
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(taskWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return taskWrapper.GetTask(), nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if it is unable to do so.
+func (res *ElastigroupAWSScheduledTaskTerraformResource) OnRead(
+	task *aws.Task,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	taskWrapper := NewElastigroupAWSScheduledTaskWrapper()
+	taskWrapper.SetTask(task)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(taskWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate is called when updating an existing resource. The changed fields
+// are applied to task, the task as currently stored on the group, and a bool
+// indicating whether anything changed is returned.
+func (res *ElastigroupAWSScheduledTaskTerraformResource) OnUpdate(
+	task *aws.Task,
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	taskWrapper := NewElastigroupAWSScheduledTaskWrapper()
+	taskWrapper.SetTask(task)
+
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(taskWrapper, resourceData, meta); err != nil {
+				return false, err
+			}
+			hasChanged = true
+		}
+	}
+
+	return hasChanged, nil
+}
+
+func NewElastigroupAWSScheduledTaskWrapper() *ElastigroupAWSScheduledTaskWrapper {
+	return &ElastigroupAWSScheduledTaskWrapper{
+		task: &aws.Task{},
+	}
+}
+
+func (taskWrapper *ElastigroupAWSScheduledTaskWrapper) GetTask() *aws.Task {
+	return taskWrapper.task
+}
+
+func (taskWrapper *ElastigroupAWSScheduledTaskWrapper) SetTask(task *aws.Task) {
+	taskWrapper.task = task
+}
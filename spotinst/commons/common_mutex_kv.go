@@ -0,0 +1,51 @@
+package commons
+
+import (
+	"log"
+	"sync"
+)
+
+// ResourceMutexKV serializes changes made by different Terraform resources to
+// the same Spotinst object, e.g. scheduled tasks and scaling policies that are
+// managed as separate resources but stored on one Elastigroup.
+var ResourceMutexKV = NewMutexKV()
+
+// MutexKV is a simple key/value store for arbitrary mutexes.
+type MutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+// NewMutexKV returns a properly initialized MutexKV.
+func NewMutexKV() *MutexKV {
+	return &MutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex for the given key. Caller is responsible for calling
+// Unlock for the same key.
+func (m *MutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+// Unlock unlocks the mutex for the given key.
+func (m *MutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *MutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
@@ -0,0 +1,125 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+)
+
+const (
+	OceanAWSScheduledTaskResourceName ResourceName = "spotinst_ocean_aws_scheduled_task"
+)
+
+var OceanAWSScheduledTaskResource *OceanAWSScheduledTaskTerraformResource
+
+type OceanAWSScheduledTaskTerraformResource struct {
+	GenericResource
+}
+
+type OceanAWSScheduledTaskWrapper struct {
+	task *aws.Task
+}
+
+func NewOceanAWSScheduledTaskResource(fieldsMap map[FieldName]*GenericField) *OceanAWSScheduledTaskTerraformResource {
+	return &OceanAWSScheduledTaskTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OceanAWSScheduledTaskResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+// OnCreate is called when creating a new resource block and returns a new Task or an error.
+func (res *OceanAWSScheduledTaskTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*aws.Task, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	taskWrapper := NewOceanAWSScheduledTaskWrapper()
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(taskWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return taskWrapper.GetTask(), nil
+}
+
+// OnRead is called when reading an existing resource and throws an error if it is unable to do so.
+func (res *OceanAWSScheduledTaskTerraformResource) OnRead(
+	task *aws.Task,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	taskWrapper := NewOceanAWSScheduledTaskWrapper()
+	taskWrapper.SetTask(task)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(taskWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate is called when updating an existing resource. The changed fields
+// are applied to task, the task as currently stored on the cluster, and a bool
+// indicating whether anything changed is returned.
+func (res *OceanAWSScheduledTaskTerraformResource) OnUpdate(
+	task *aws.Task,
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	taskWrapper := NewOceanAWSScheduledTaskWrapper()
+	taskWrapper.SetTask(task)
+
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(taskWrapper, resourceData, meta); err != nil {
+				return false, err
+			}
+			hasChanged = true
+		}
+	}
+
+	return hasChanged, nil
+}
+
+func NewOceanAWSScheduledTaskWrapper() *OceanAWSScheduledTaskWrapper {
+	return &OceanAWSScheduledTaskWrapper{
+		task: &aws.Task{},
+	}
+}
+
+func (taskWrapper *OceanAWSScheduledTaskWrapper) GetTask() *aws.Task {
+	return taskWrapper.task
+}
+
+func (taskWrapper *OceanAWSScheduledTaskWrapper) SetTask(task *aws.Task) {
+	taskWrapper.task = task
+}
@@ -0,0 +1,400 @@
+package commons
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
+)
+
+// ECSTaskDefinition holds the parts of an ECS task definition that decide
+// how much capacity a task needs and where it can be placed, as used by the
+// headroom of Ocean ECS clusters and launch specs.
+type ECSTaskDefinition struct {
+	CPU         int // CPU units, 1024 per vCPU
+	Memory      int // MiB
+	GPU         int
+	Constraints []*ECSPlacementConstraint
+}
+
+// ECSPlacementConstraint is a memberOf placement constraint whose expression
+// could be parsed. Expressions using or, parentheses, exists or =~ are not
+// evaluated and are left out.
+type ECSPlacementConstraint struct {
+	Expression string
+	terms      []ecsConstraintTerm
+}
+
+type ecsConstraintTerm struct {
+	attribute string
+	negate    bool
+	values    []string
+}
+
+// ECSInstance describes an instance a task could be placed on. VCPU is zero
+// when the instance type is missing from the instance catalog, in which case
+// the task is reported as not known to fit. An instance without an instance
+// type stands for any instance, and only its placement constraints are
+// checked. Attributes holds the custom attributes of the instance; when nil
+// they are not known and constraints on custom attributes are assumed to
+// match.
+type ECSInstance struct {
+	InstanceType string
+	Architecture string
+	VCPU         int
+	MemoryGiB    float64
+	GPU          int
+	Attributes   map[string]string
+}
+
+type ecsTaskDefinitionJSON struct {
+	TaskDefinition       *ecsTaskDefinitionJSON `json:"taskDefinition"`
+	CPU                  interface{}            `json:"cpu"`
+	Memory               interface{}            `json:"memory"`
+	ContainerDefinitions []*ecsContainerJSON    `json:"containerDefinitions"`
+	PlacementConstraints []struct {
+		Type       string `json:"type"`
+		Expression string `json:"expression"`
+	} `json:"placementConstraints"`
+}
+
+type ecsContainerJSON struct {
+	Name                 string      `json:"name"`
+	CPU                  interface{} `json:"cpu"`
+	Memory               interface{} `json:"memory"`
+	MemoryReservation    interface{} `json:"memoryReservation"`
+	ResourceRequirements []struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"resourceRequirements"`
+}
+
+// The headroom arguments shared by Ocean ECS clusters and launch specs.
+const (
+	ecsHeadroomCPUPerUnit     = "cpu_per_unit"
+	ecsHeadroomMemoryPerUnit  = "memory_per_unit"
+	ecsHeadroomTaskDefinition = "task_definition"
+)
+
+var (
+	ecsTaskDefinitionRefRegex = regexp.MustCompile(`^(arn:aws[a-zA-Z-]*:ecs:.+:task-definition/.+|[A-Za-z0-9_-]+:\d+)$`)
+	ecsConstraintAndRegex     = regexp.MustCompile(`(?i)\s+and\s+`)
+	ecsConstraintCompareRegex = regexp.MustCompile(`^attribute:([A-Za-z0-9_./:-]+)\s*(==|!=)\s*(\S+)$`)
+	ecsConstraintInRegex      = regexp.MustCompile(`(?i)^attribute:([A-Za-z0-9_./:-]+)\s+(in|not\s+in)\s*\[(.*)\]$`)
+	ecsConstraintOrRegex      = regexp.MustCompile(`(?i)\s+or\s+|[()]|\s+exists$|=~`)
+)
+
+// ParseECSTaskDefinition parses the JSON of an ECS task definition: either
+// the output of `aws ecs describe-task-definition`, the task definition
+// object itself or a container definitions array. Task-level cpu and memory
+// take precedence over the sum of the container values.
+func ParseECSTaskDefinition(s string) (*ECSTaskDefinition, error) {
+	s = strings.TrimSpace(s)
+	if ecsTaskDefinitionRefRegex.MatchString(s) {
+		return nil, fmt.Errorf("task definition ARNs and family:revision references cannot be resolved by the provider, use the task definition JSON instead")
+	}
+
+	raw := &ecsTaskDefinitionJSON{}
+	if strings.HasPrefix(s, "[") {
+		if err := json.Unmarshal([]byte(s), &raw.ContainerDefinitions); err != nil {
+			return nil, fmt.Errorf("invalid container definitions JSON: %v", err)
+		}
+	} else if err := json.Unmarshal([]byte(s), raw); err != nil {
+		return nil, fmt.Errorf("invalid task definition JSON: %v", err)
+	}
+	if raw.TaskDefinition != nil {
+		raw = raw.TaskDefinition
+	}
+	if len(raw.ContainerDefinitions) == 0 {
+		return nil, fmt.Errorf("the task definition has no container definitions")
+	}
+
+	td := &ECSTaskDefinition{}
+	var err error
+	var containerCPU, containerMemory int
+	for i, c := range raw.ContainerDefinitions {
+		if c == nil {
+			continue
+		}
+		name := c.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+
+		cpu, err := parseECSUnits(c.CPU, 1)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: cpu: %v", name, err)
+		}
+		containerCPU += cpu
+
+		memory, err := parseECSUnits(c.Memory, 1)
+		if err != nil {
+			return nil, fmt.Errorf("container %s: memory: %v", name, err)
+		}
+		if memory == 0 {
+			if memory, err = parseECSUnits(c.MemoryReservation, 1); err != nil {
+				return nil, fmt.Errorf("container %s: memoryReservation: %v", name, err)
+			}
+		}
+		if memory == 0 && raw.Memory == nil {
+			return nil, fmt.Errorf("container %s: neither memory nor memoryReservation is set, and the task has no memory", name)
+		}
+		containerMemory += memory
+
+		for _, r := range c.ResourceRequirements {
+			if strings.EqualFold(r.Type, "GPU") {
+				gpu, err := strconv.Atoi(r.Value)
+				if err != nil {
+					return nil, fmt.Errorf("container %s: invalid GPU count %q", name, r.Value)
+				}
+				td.GPU += gpu
+			}
+		}
+	}
+
+	if td.CPU, err = parseECSUnits(raw.CPU, 1024); err != nil {
+		return nil, fmt.Errorf("cpu: %v", err)
+	}
+	if td.CPU == 0 {
+		td.CPU = containerCPU
+	}
+	if td.Memory, err = parseECSUnits(raw.Memory, 1024); err != nil {
+		return nil, fmt.Errorf("memory: %v", err)
+	}
+	if td.Memory == 0 {
+		td.Memory = containerMemory
+	}
+
+	for _, c := range raw.PlacementConstraints {
+		if c.Type != "memberOf" {
+			continue
+		}
+		if terms, ok := parseECSConstraintExpression(c.Expression); ok {
+			td.Constraints = append(td.Constraints, &ECSPlacementConstraint{Expression: c.Expression, terms: terms})
+		}
+	}
+	return td, nil
+}
+
+// parseECSUnits parses a CPU or memory value, given either as a number or as
+// a string such as "256", "0.5 vCPU" or "2 GB". Values with a unit are
+// multiplied by perUnit (1024 CPU units per vCPU, 1024 MiB per GB).
+func parseECSUnits(v interface{}, perUnit float64) (int, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return int(v), nil
+	case string:
+		s := strings.TrimSpace(v)
+		if s == "" {
+			return 0, nil
+		}
+		if n, err := strconv.Atoi(s); err == nil {
+			return n, nil
+		}
+		fields := strings.Fields(strings.ToLower(s))
+		number := strings.TrimRight(fields[0], "vcpugb")
+		unit := strings.TrimPrefix(s[len(number):], " ")
+		f, err := strconv.ParseFloat(number, 64)
+		if err != nil || (!strings.EqualFold(unit, "vcpu") && !strings.EqualFold(unit, "gb")) {
+			return 0, fmt.Errorf("invalid value %q", v)
+		}
+		return int(math.Round(f * perUnit)), nil
+	}
+	return 0, fmt.Errorf("invalid value %v", v)
+}
+
+// parseECSConstraintExpression parses a cluster query language expression
+// made of comparisons joined by and. It reports false for expressions it
+// cannot evaluate.
+func parseECSConstraintExpression(expression string) ([]ecsConstraintTerm, bool) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" || ecsConstraintOrRegex.MatchString(expression) {
+		return nil, false
+	}
+
+	var terms []ecsConstraintTerm
+	for _, part := range ecsConstraintAndRegex.Split(expression, -1) {
+		part = strings.TrimSpace(part)
+		if m := ecsConstraintCompareRegex.FindStringSubmatch(part); m != nil {
+			terms = append(terms, ecsConstraintTerm{
+				attribute: m[1],
+				negate:    m[2] == "!=",
+				values:    []string{strings.Trim(m[3], `'"`)},
+			})
+			continue
+		}
+		if m := ecsConstraintInRegex.FindStringSubmatch(part); m != nil {
+			var values []string
+			for _, value := range strings.Split(m[3], ",") {
+				if value = strings.Trim(strings.TrimSpace(value), `'"`); value != "" {
+					values = append(values, value)
+				}
+			}
+			terms = append(terms, ecsConstraintTerm{
+				attribute: m[1],
+				negate:    !strings.EqualFold(m[2], "in"),
+				values:    values,
+			})
+			continue
+		}
+		return nil, false
+	}
+	return terms, true
+}
+
+// matches reports whether an instance with the given attributes satisfies
+// the constraint. Built-in ecs.* attributes that are missing from attributes
+// are not known at plan time and are assumed to match, as are custom
+// attributes unless customKnown is set.
+func (c *ECSPlacementConstraint) matches(attributes map[string]string, customKnown bool) bool {
+	for _, term := range c.terms {
+		value, ok := attributes[term.attribute]
+		if !ok && (!customKnown || strings.HasPrefix(term.attribute, "ecs.")) {
+			continue
+		}
+
+		matched := false
+		if ok {
+			for _, pattern := range term.values {
+				if m, _ := path.Match(pattern, value); m {
+					matched = true
+					break
+				}
+			}
+		}
+		if matched == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// Placeable returns the reason a task of the task definition cannot be
+// placed on instance, or nil if it can.
+func (td *ECSTaskDefinition) Placeable(instance *ECSInstance) error {
+	if instance.InstanceType != "" && instance.VCPU == 0 {
+		return fmt.Errorf("%s: not in the instance type catalog, its size is unknown", instance.InstanceType)
+	}
+	if instance.VCPU > 0 {
+		if td.CPU > instance.VCPU*1024 {
+			return fmt.Errorf("%s: needs %d CPU units, has %d", instance.InstanceType, td.CPU, instance.VCPU*1024)
+		}
+		if float64(td.Memory) > instance.MemoryGiB*1024 {
+			return fmt.Errorf("%s: needs %d MiB of memory, has %d", instance.InstanceType, td.Memory, int(instance.MemoryGiB*1024))
+		}
+		if td.GPU > instance.GPU {
+			return fmt.Errorf("%s: needs %d GPUs, has %d", instance.InstanceType, td.GPU, instance.GPU)
+		}
+	}
+
+	attributes := make(map[string]string, len(instance.Attributes)+2)
+	for k, v := range instance.Attributes {
+		attributes[k] = v
+	}
+	if instance.InstanceType != "" {
+		attributes["ecs.instance-type"] = instance.InstanceType
+	}
+	if instance.Architecture != "" {
+		attributes["ecs.cpu-architecture"] = instance.Architecture
+	}
+	for _, c := range td.Constraints {
+		if !c.matches(attributes, instance.Attributes != nil) {
+			name := instance.InstanceType
+			if name == "" {
+				name = "instances"
+			}
+			return fmt.Errorf("%s: placement constraint %q is not satisfied", name, c.Expression)
+		}
+	}
+	return nil
+}
+
+// PlaceableOnAny returns an error listing why the task cannot be placed on
+// any of instances, or nil if it fits on at least one of them. Instances of
+// unknown size never count as fitting.
+func (td *ECSTaskDefinition) PlaceableOnAny(instances []*ECSInstance) error {
+	reasons := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		err := td.Placeable(instance)
+		if err == nil {
+			return nil
+		}
+		reasons = append(reasons, err.Error())
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the task (%d CPU units, %d MiB, %d GPUs) cannot be placed on any instance type: %s",
+		td.CPU, td.Memory, td.GPU, strings.Join(reasons, "; "))
+}
+
+// ExpandECSHeadroomTaskDefinition parses the task_definition of the headroom
+// m of field. The CPU and memory per unit of the headroom are computed from
+// the task definition and must not be set as well.
+func ExpandECSHeadroomTaskDefinition(field FieldName, m map[string]interface{}) (*ECSTaskDefinition, error) {
+	cpu, _ := m[ecsHeadroomCPUPerUnit].(int)
+	memory, _ := m[ecsHeadroomMemoryPerUnit].(int)
+	if cpu != 0 || memory != 0 {
+		return nil, fmt.Errorf("%s: %s and %s cannot be set together with %s",
+			field, ecsHeadroomCPUPerUnit, ecsHeadroomMemoryPerUnit, ecsHeadroomTaskDefinition)
+	}
+
+	taskDefinition, _ := m[ecsHeadroomTaskDefinition].(string)
+	td, err := ParseECSTaskDefinition(taskDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s: %v", field, ecsHeadroomTaskDefinition, err)
+	}
+	return td, nil
+}
+
+// ECSInstances returns the instances of the given AWS instance types, sized
+// from the instance catalog and carrying the given custom attributes.
+// Instance types missing from the catalog are returned with a zero size. With
+// no instance types, a single instance of unknown type is returned, so that
+// only the attribute constraints are checked.
+func ECSInstances(instanceTypes []string, attributes map[string]string) ([]*ECSInstance, error) {
+	if len(instanceTypes) == 0 {
+		return []*ECSInstance{{Attributes: attributes}}, nil
+	}
+
+	catalog, err := instance_catalog.Load(instance_catalog.AWS)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]*ECSInstance, 0, len(instanceTypes))
+	for _, name := range instanceTypes {
+		instance := &ECSInstance{InstanceType: name, Attributes: attributes}
+		if t := catalog.Lookup(name); t != nil {
+			instance.Architecture = t.Architecture
+			instance.VCPU = t.VCPU
+			instance.MemoryGiB = t.MemoryGiB
+			instance.GPU = t.GPU
+		}
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+// ValidateECSTaskDefinition is a schema.SchemaValidateFunc for task
+// definition JSON. An empty string is accepted.
+func ValidateECSTaskDefinition(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if v == "" {
+		return nil, nil
+	}
+	if _, err := ParseECSTaskDefinition(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be an ECS task definition: %v", k, err)}
+	}
+	return nil, nil
+}
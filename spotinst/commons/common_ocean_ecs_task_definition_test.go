@@ -0,0 +1,328 @@
+package commons
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseECSTaskDefinition(t *testing.T) {
+	cases := []struct {
+		name        string
+		json        string
+		expected    *ECSTaskDefinition
+		constraints []string
+		err         string
+	}{
+		{
+			name:     "task definition",
+			json:     `{"family":"web","cpu":"512","memory":"1024","containerDefinitions":[{"name":"web","cpu":256,"memory":512}]}`,
+			expected: &ECSTaskDefinition{CPU: 512, Memory: 1024},
+		},
+		{
+			name:     "describe-task-definition output",
+			json:     `{"taskDefinition":{"cpu":"1 vCPU","memory":"2 GB","containerDefinitions":[{"name":"web"}]}}`,
+			expected: &ECSTaskDefinition{CPU: 1024, Memory: 2048},
+		},
+		{
+			name:     "container values are summed",
+			json:     `{"containerDefinitions":[{"name":"web","cpu":256,"memory":512},{"name":"sidecar","cpu":128,"memoryReservation":64}]}`,
+			expected: &ECSTaskDefinition{CPU: 384, Memory: 576},
+		},
+		{
+			name:     "container definitions array",
+			json:     `[{"name":"web","cpu":256,"memory":512}]`,
+			expected: &ECSTaskDefinition{CPU: 256, Memory: 512},
+		},
+		{
+			name: "GPUs",
+			json: `{"containerDefinitions":[{"name":"a","memory":512,"resourceRequirements":[{"type":"GPU","value":"1"}]},` +
+				`{"name":"b","memory":512,"resourceRequirements":[{"type":"InferenceAccelerator","value":"x"},{"type":"gpu","value":"2"}]}]}`,
+			expected: &ECSTaskDefinition{Memory: 1024, GPU: 3},
+		},
+		{
+			name: "placement constraints",
+			json: `{"containerDefinitions":[{"name":"web","memory":512}],"placementConstraints":[` +
+				`{"type":"memberOf","expression":"attribute:ecs.instance-type =~ t2.*"},` +
+				`{"type":"distinctInstance"},` +
+				`{"type":"memberOf","expression":"attribute:ecs.cpu-architecture == arm64 and attribute:team in [web, api]"}]}`,
+			expected:    &ECSTaskDefinition{Memory: 512},
+			constraints: []string{"attribute:ecs.cpu-architecture == arm64 and attribute:team in [web, api]"},
+		},
+		{
+			name: "ARN",
+			json: "arn:aws:ecs:us-east-1:123456789012:task-definition/web:3",
+			err:  "cannot be resolved by the provider",
+		},
+		{
+			name: "family and revision",
+			json: "web:3",
+			err:  "cannot be resolved by the provider",
+		},
+		{
+			name: "invalid JSON",
+			json: `{"containerDefinitions":`,
+			err:  "invalid task definition JSON",
+		},
+		{
+			name: "invalid container definitions JSON",
+			json: `[{"name":`,
+			err:  "invalid container definitions JSON",
+		},
+		{
+			name: "no containers",
+			json: `{"cpu":"256","memory":"512"}`,
+			err:  "no container definitions",
+		},
+		{
+			name: "no memory",
+			json: `{"containerDefinitions":[{"name":"web","cpu":256}]}`,
+			err:  "container web: neither memory nor memoryReservation is set",
+		},
+		{
+			name: "invalid container CPU",
+			json: `[{"cpu":"lots","memory":512}]`,
+			err:  "container 0: cpu: invalid value \"lots\"",
+		},
+		{
+			name: "invalid GPU count",
+			json: `[{"name":"web","memory":512,"resourceRequirements":[{"type":"GPU","value":"one"}]}]`,
+			err:  "container web: invalid GPU count \"one\"",
+		},
+		{
+			name: "invalid task memory",
+			json: `{"memory":"2 TB","containerDefinitions":[{"name":"web"}]}`,
+			err:  "memory: invalid value \"2 TB\"",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			td, err := ParseECSTaskDefinition(c.json)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Errorf("expected error containing %q, got %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			var constraints []string
+			for _, constraint := range td.Constraints {
+				constraints = append(constraints, constraint.Expression)
+			}
+			if !reflect.DeepEqual(constraints, c.constraints) {
+				t.Errorf("expected constraints %q, got %q", c.constraints, constraints)
+			}
+			td.Constraints = nil
+			if !reflect.DeepEqual(td, c.expected) {
+				t.Errorf("expected %+v, got %+v", *c.expected, *td)
+			}
+		})
+	}
+}
+
+func TestParseECSUnits(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		perUnit  float64
+		expected int
+		err      bool
+	}{
+		{"nil", nil, 1024, 0, false},
+		{"number", float64(256), 1024, 256, false},
+		{"empty string", " ", 1024, 0, false},
+		{"string", "512", 1024, 512, false},
+		{"vCPU", "0.5 vCPU", 1024, 512, false},
+		{"vCPU without a space", "2vcpu", 1024, 2048, false},
+		{"GB", "4 GB", 1024, 4096, false},
+		{"fractional GB", "0.5GB", 1024, 512, false},
+		{"unknown unit", "2 TB", 1024, 0, true},
+		{"no number", "vCPU", 1024, 0, true},
+		{"not a number", "lots", 1024, 0, true},
+		{"bool", true, 1024, 0, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := parseECSUnits(c.value, c.perUnit)
+			if c.err {
+				if err == nil {
+					t.Errorf("expected an error, got %d", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != c.expected {
+				t.Errorf("expected %d, got %d", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseECSConstraintExpression(t *testing.T) {
+	cases := []struct {
+		expression string
+		expected   []ecsConstraintTerm
+		ok         bool
+	}{
+		{
+			expression: "attribute:ecs.instance-type == t3.large",
+			expected:   []ecsConstraintTerm{{attribute: "ecs.instance-type", values: []string{"t3.large"}}},
+			ok:         true,
+		},
+		{
+			expression: "attribute:team != 'web'",
+			expected:   []ecsConstraintTerm{{attribute: "team", negate: true, values: []string{"web"}}},
+			ok:         true,
+		},
+		{
+			expression: "attribute:ecs.instance-type in [t3.*, \"m5.large\", ]",
+			expected:   []ecsConstraintTerm{{attribute: "ecs.instance-type", values: []string{"t3.*", "m5.large"}}},
+			ok:         true,
+		},
+		{
+			expression: "attribute:ecs.availability-zone NOT IN [us-east-1a]",
+			expected:   []ecsConstraintTerm{{attribute: "ecs.availability-zone", negate: true, values: []string{"us-east-1a"}}},
+			ok:         true,
+		},
+		{
+			expression: "attribute:ecs.cpu-architecture == arm64 AND attribute:team in [web]",
+			expected: []ecsConstraintTerm{
+				{attribute: "ecs.cpu-architecture", values: []string{"arm64"}},
+				{attribute: "team", values: []string{"web"}},
+			},
+			ok: true,
+		},
+		{expression: ""},
+		{expression: "attribute:team == web or attribute:team == api"},
+		{expression: "(attribute:team == web)"},
+		{expression: "attribute:team exists"},
+		{expression: "attribute:ecs.instance-type =~ t2.*"},
+		{expression: "runningTasksCount == 0"},
+		{expression: "attribute:team == web and task:group == service:web"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expression, func(t *testing.T) {
+			terms, ok := parseECSConstraintExpression(c.expression)
+			if ok != c.ok {
+				t.Fatalf("expected ok %v, got %v", c.ok, ok)
+			}
+			if !reflect.DeepEqual(terms, c.expected) {
+				t.Errorf("expected %+v, got %+v", c.expected, terms)
+			}
+		})
+	}
+}
+
+func TestECSTaskDefinitionPlaceableOnAny(t *testing.T) {
+	td, err := ParseECSTaskDefinition(`{"cpu":"2048","memory":"4096","containerDefinitions":[{"name":"web"}],` +
+		`"placementConstraints":[{"type":"memberOf","expression":"attribute:team == web"}]}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name      string
+		instances []*ECSInstance
+		err       string
+	}{
+		{
+			name:      "fits",
+			instances: []*ECSInstance{{InstanceType: "m5.large", VCPU: 2, MemoryGiB: 8}},
+		},
+		{
+			name:      "too small",
+			instances: []*ECSInstance{{InstanceType: "t3.small", VCPU: 2, MemoryGiB: 2}},
+			err:       "t3.small: needs 4096 MiB of memory, has 2048",
+		},
+		{
+			name: "fits one of several",
+			instances: []*ECSInstance{
+				{InstanceType: "t3.micro", VCPU: 2, MemoryGiB: 1},
+				{InstanceType: "m5.large", VCPU: 2, MemoryGiB: 8},
+			},
+		},
+		{
+			name:      "unknown instance type",
+			instances: []*ECSInstance{{InstanceType: "m99.large"}},
+			err:       "m99.large: not in the instance type catalog, its size is unknown",
+		},
+		{
+			name:      "any instance",
+			instances: []*ECSInstance{{}},
+		},
+		{
+			name:      "custom attribute satisfied",
+			instances: []*ECSInstance{{InstanceType: "m5.large", VCPU: 2, MemoryGiB: 8, Attributes: map[string]string{"team": "web"}}},
+		},
+		{
+			name:      "custom attribute not satisfied",
+			instances: []*ECSInstance{{Attributes: map[string]string{"team": "api"}}},
+			err:       `instances: placement constraint "attribute:team == web" is not satisfied`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := td.PlaceableOnAny(c.instances)
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("expected error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestExpandECSHeadroomTaskDefinition(t *testing.T) {
+	taskDefinition := `[{"name":"web","cpu":256,"memory":512}]`
+	cases := []struct {
+		name string
+		m    map[string]interface{}
+		err  string
+	}{
+		{
+			name: "task definition",
+			m:    map[string]interface{}{"task_definition": taskDefinition, "cpu_per_unit": 0, "memory_per_unit": 0, "num_of_units": 2},
+		},
+		{
+			name: "with cpu_per_unit",
+			m:    map[string]interface{}{"task_definition": taskDefinition, "cpu_per_unit": 256},
+			err:  "headroom: cpu_per_unit and memory_per_unit cannot be set together with task_definition",
+		},
+		{
+			name: "invalid task definition",
+			m:    map[string]interface{}{"task_definition": "web:3"},
+			err:  "headroom: task_definition: task definition ARNs",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			td, err := ExpandECSHeadroomTaskDefinition("headroom", c.m)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Errorf("expected error containing %q, got %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if td.CPU != 256 || td.Memory != 512 {
+				t.Errorf("expected 256 CPU units and 512 MiB, got %+v", *td)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	OrgPolicyAttachmentResourceName ResourceName = "spotinst_organization_policy_attachment"
+)
+
+var OrgPolicyAttachmentResource *OrgPolicyAttachmentTerraformResource
+
+type OrgPolicyAttachmentTerraformResource struct {
+	GenericResource
+}
+
+// OrgPolicyAttachment is a single policy of a user or a user group, with the
+// accounts it applies to. Exactly one of UserGroupID and UserID is set.
+type OrgPolicyAttachment struct {
+	PolicyID    *string
+	UserGroupID *string
+	UserID      *string
+	AccountIDs  []string
+}
+
+type OrgPolicyAttachmentWrapper struct {
+	attachment *OrgPolicyAttachment
+}
+
+func NewOrgPolicyAttachmentResource(fieldsMap map[FieldName]*GenericField) *OrgPolicyAttachmentTerraformResource {
+	return &OrgPolicyAttachmentTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OrgPolicyAttachmentResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *OrgPolicyAttachmentTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*OrgPolicyAttachment, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	attachmentWrapper := NewOrgPolicyAttachmentWrapper()
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(attachmentWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return attachmentWrapper.GetAttachment(), nil
+}
+
+func (res *OrgPolicyAttachmentTerraformResource) OnRead(
+	attachment *OrgPolicyAttachment,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	attachmentWrapper := NewOrgPolicyAttachmentWrapper()
+	attachmentWrapper.SetAttachment(attachment)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(attachmentWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnUpdate returns the attachment with the changed fields set, with a bool
+// indicating whether any of them changed.
+func (res *OrgPolicyAttachmentTerraformResource) OnUpdate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (bool, *OrgPolicyAttachment, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return false, nil, fmt.Errorf("resource fields are nil or empty, cannot update")
+	}
+
+	attachmentWrapper := NewOrgPolicyAttachmentWrapper()
+	hasChanged := false
+	for _, field := range res.fields.fieldsMap {
+		if field.onUpdate == nil {
+			continue
+		}
+		if field.hasFieldChange(resourceData, meta) {
+			log.Printf(string(ResourceFieldOnUpdate), field.resourceAffinity, field.fieldNameStr)
+			if err := field.onUpdate(attachmentWrapper, resourceData, meta); err != nil {
+				return false, nil, err
+			}
+			hasChanged = true
+		}
+	}
+	return hasChanged, attachmentWrapper.GetAttachment(), nil
+}
+
+func NewOrgPolicyAttachmentWrapper() *OrgPolicyAttachmentWrapper {
+	return &OrgPolicyAttachmentWrapper{
+		attachment: &OrgPolicyAttachment{},
+	}
+}
+
+func (attachmentWrapper *OrgPolicyAttachmentWrapper) GetAttachment() *OrgPolicyAttachment {
+	return attachmentWrapper.attachment
+}
+
+func (attachmentWrapper *OrgPolicyAttachmentWrapper) SetAttachment(attachment *OrgPolicyAttachment) {
+	attachmentWrapper.attachment = attachment
+}
@@ -0,0 +1,98 @@
+package commons
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	OrgUserGroupMembershipResourceName ResourceName = "spotinst_organization_user_group_membership"
+)
+
+var OrgUserGroupMembershipResource *OrgUserGroupMembershipTerraformResource
+
+type OrgUserGroupMembershipTerraformResource struct {
+	GenericResource
+}
+
+// OrgUserGroupMembership is a single user of a user group. The API only
+// manages the users of a group as a whole, so there is no SDK type for it.
+type OrgUserGroupMembership struct {
+	UserGroupID *string
+	UserID      *string
+}
+
+type OrgUserGroupMembershipWrapper struct {
+	membership *OrgUserGroupMembership
+}
+
+func NewOrgUserGroupMembershipResource(fieldsMap map[FieldName]*GenericField) *OrgUserGroupMembershipTerraformResource {
+	return &OrgUserGroupMembershipTerraformResource{
+		GenericResource: GenericResource{
+			resourceName: OrgUserGroupMembershipResourceName,
+			fields:       NewGenericFields(fieldsMap),
+		},
+	}
+}
+
+func (res *OrgUserGroupMembershipTerraformResource) OnCreate(
+	resourceData *schema.ResourceData,
+	meta interface{}) (*OrgUserGroupMembership, error) {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return nil, fmt.Errorf("resource fields are nil or empty, cannot create")
+	}
+
+	membershipWrapper := NewOrgUserGroupMembershipWrapper()
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onCreate == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnCreate), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onCreate(membershipWrapper, resourceData, meta); err != nil {
+			return nil, err
+		}
+	}
+	return membershipWrapper.GetMembership(), nil
+}
+
+func (res *OrgUserGroupMembershipTerraformResource) OnRead(
+	membership *OrgUserGroupMembership,
+	resourceData *schema.ResourceData,
+	meta interface{}) error {
+
+	if res.fields == nil || res.fields.fieldsMap == nil || len(res.fields.fieldsMap) == 0 {
+		return fmt.Errorf("resource fields are nil or empty, cannot read")
+	}
+
+	membershipWrapper := NewOrgUserGroupMembershipWrapper()
+	membershipWrapper.SetMembership(membership)
+
+	for _, field := range res.fields.fieldsMap {
+		if field.onRead == nil {
+			continue
+		}
+		log.Printf(string(ResourceFieldOnRead), field.resourceAffinity, field.fieldNameStr)
+		if err := field.onRead(membershipWrapper, resourceData, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func NewOrgUserGroupMembershipWrapper() *OrgUserGroupMembershipWrapper {
+	return &OrgUserGroupMembershipWrapper{
+		membership: &OrgUserGroupMembership{},
+	}
+}
+
+func (membershipWrapper *OrgUserGroupMembershipWrapper) GetMembership() *OrgUserGroupMembership {
+	return membershipWrapper.membership
+}
+
+func (membershipWrapper *OrgUserGroupMembershipWrapper) SetMembership(membership *OrgUserGroupMembership) {
+	membershipWrapper.membership = membership
+}
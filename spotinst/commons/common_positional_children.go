@@ -0,0 +1,63 @@
+package commons
+
+// PositionalChildren manages the Spotinst objects a resource creates for the
+// blocks of a list after the first one, e.g. a subscription per endpoint or a
+// health check per check. The first block is the resource itself. Blocks are
+// matched to the objects in state by position.
+type PositionalChildren struct {
+	// Create creates the object of block i and returns its ID.
+	Create func(i int) (string, error)
+
+	// Update updates the object of block i, whose ID is id.
+	Update func(i int, id string) error
+
+	// Delete deletes the object of a removed block.
+	Delete func(id string) error
+}
+
+// Sync updates the objects of the blocks that already have one, creates the
+// objects of new blocks and deletes those of removed blocks. oldIDs are the
+// object IDs in state, by block, and count is the number of blocks now.
+//
+// It returns the object IDs of the current blocks, with id first, and the
+// indexes in oldIDs of the removed blocks whose objects still exist. Both are
+// valid when Sync fails as well, so that callers can keep every object that
+// exists in state: an object whose ID is lost is never deleted.
+func (c *PositionalChildren) Sync(id string, oldIDs []string, count int) ([]string, []int, error) {
+	ids := make([]string, count)
+	for i := 1; i < count && i < len(oldIDs); i++ {
+		ids[i] = oldIDs[i]
+	}
+	if count > 0 {
+		ids[0] = id
+	}
+
+	var removed []int
+	for i := max(count, 1); i < len(oldIDs); i++ {
+		if oldIDs[i] != "" {
+			removed = append(removed, i)
+		}
+	}
+
+	for i := 1; i < count; i++ {
+		if ids[i] != "" {
+			if err := c.Update(i, ids[i]); err != nil {
+				return ids, removed, err
+			}
+			continue
+		}
+		childID, err := c.Create(i)
+		if err != nil {
+			return ids, removed, err
+		}
+		ids[i] = childID
+	}
+
+	for len(removed) > 0 {
+		if err := c.Delete(oldIDs[removed[0]]); err != nil {
+			return ids, removed, err
+		}
+		removed = removed[1:]
+	}
+	return ids, nil, nil
+}
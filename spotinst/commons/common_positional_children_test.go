@@ -0,0 +1,126 @@
+package commons
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPositionalChildrenSync(t *testing.T) {
+	cases := []struct {
+		name     string
+		oldIDs   []string
+		count    int
+		failOn   string
+		ids      []string
+		removed  []int
+		calls    []string
+		hasError bool
+	}{
+		{
+			name:   "create",
+			oldIDs: nil,
+			count:  3,
+			ids:    []string{"id-0", "new-1", "new-2"},
+			calls:  []string{"create 1", "create 2"},
+		},
+		{
+			name:   "update and create",
+			oldIDs: []string{"id-0", "old-1"},
+			count:  3,
+			ids:    []string{"id-0", "old-1", "new-2"},
+			calls:  []string{"update 1 old-1", "create 2"},
+		},
+		{
+			name:   "recreate missing",
+			oldIDs: []string{"id-0", ""},
+			count:  2,
+			ids:    []string{"id-0", "new-1"},
+			calls:  []string{"create 1"},
+		},
+		{
+			name:   "delete removed",
+			oldIDs: []string{"id-0", "old-1", "old-2", ""},
+			count:  1,
+			ids:    []string{"id-0"},
+			calls:  []string{"delete old-1", "delete old-2"},
+		},
+		{
+			name:   "delete all",
+			oldIDs: []string{"id-0", "old-1"},
+			count:  0,
+			ids:    []string{},
+			calls:  []string{"delete old-1"},
+		},
+		{
+			name:     "create fails",
+			oldIDs:   []string{"id-0"},
+			count:    4,
+			failOn:   "create 3",
+			ids:      []string{"id-0", "new-1", "new-2", ""},
+			calls:    []string{"create 1", "create 2", "create 3"},
+			hasError: true,
+		},
+		{
+			name:     "update fails",
+			oldIDs:   []string{"id-0", "old-1", "old-2", "old-3"},
+			count:    3,
+			failOn:   "update 1 old-1",
+			ids:      []string{"id-0", "old-1", "old-2"},
+			removed:  []int{3},
+			calls:    []string{"update 1 old-1"},
+			hasError: true,
+		},
+		{
+			name:     "delete fails",
+			oldIDs:   []string{"id-0", "old-1", "old-2", "old-3"},
+			count:    2,
+			failOn:   "delete old-3",
+			ids:      []string{"id-0", "old-1"},
+			removed:  []int{3},
+			calls:    []string{"update 1 old-1", "delete old-2", "delete old-3"},
+			hasError: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var calls []string
+			call := func(s string) error {
+				calls = append(calls, s)
+				if s == c.failOn {
+					return fmt.Errorf("failed: %s", s)
+				}
+				return nil
+			}
+			children := &PositionalChildren{
+				Create: func(i int) (string, error) {
+					if err := call(fmt.Sprintf("create %d", i)); err != nil {
+						return "", err
+					}
+					return fmt.Sprintf("new-%d", i), nil
+				},
+				Update: func(i int, id string) error {
+					return call(fmt.Sprintf("update %d %s", i, id))
+				},
+				Delete: func(id string) error {
+					return call(fmt.Sprintf("delete %s", id))
+				},
+			}
+
+			ids, removed, err := children.Sync("id-0", c.oldIDs, c.count)
+			if (err != nil) != c.hasError {
+				t.Fatalf("expected error %v, got %v", c.hasError, err)
+			}
+			if !reflect.DeepEqual(ids, c.ids) {
+				t.Errorf("expected IDs %v, got %v", c.ids, ids)
+			}
+			if !reflect.DeepEqual(removed, c.removed) {
+				t.Errorf("expected removed %v, got %v", c.removed, removed)
+			}
+			if !reflect.DeepEqual(calls, c.calls) {
+				t.Errorf("expected calls %v, got %v", c.calls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package commons
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the values of sensitive fields in debug logs.
+const RedactedValue = "<sensitive>"
+
+// sensitivePaths is the registry of API fields whose values must never be
+// logged. Each path is a dot-separated list of JSON keys that matches any
+// field whose path ends with it, e.g. "kubernetes.token" matches
+// "group.integration.kubernetes.token". Array indices are not part of paths.
+// keys holds, by the last key of each path, the regexp that matches the string
+// values of that key in documents that cannot be decoded.
+var sensitivePaths = struct {
+	sync.RWMutex
+	paths map[string][]string
+	keys  map[string]*regexp.Regexp
+}{
+	paths: make(map[string][]string),
+	keys:  make(map[string]*regexp.Regexp),
+}
+
+// RegisterSensitivePaths adds API field paths to the registry of fields
+// redacted from debug logs. Field packages register the API counterparts of
+// the schema fields they mark as Sensitive.
+func RegisterSensitivePaths(paths ...string) {
+	sensitivePaths.Lock()
+	defer sensitivePaths.Unlock()
+
+	for _, path := range paths {
+		keys := strings.Split(path, ".")
+		sensitivePaths.paths[path] = keys
+
+		key := keys[len(keys)-1]
+		if _, ok := sensitivePaths.keys[key]; !ok {
+			sensitivePaths.keys[key] = regexp.MustCompile(`("` + regexp.QuoteMeta(key) + `"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+		}
+	}
+}
+
+// isSensitivePath reports whether the field at path matches a registered path.
+func isSensitivePath(path []string) bool {
+	sensitivePaths.RLock()
+	defer sensitivePaths.RUnlock()
+
+	for _, suffix := range sensitivePaths.paths {
+		if len(suffix) > len(path) {
+			continue
+		}
+		matches := true
+		offset := len(path) - len(suffix)
+		for i, key := range suffix {
+			if path[offset+i] != key {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitiveKeyRegexps returns the regexps of the last keys of the registered
+// paths.
+func sensitiveKeyRegexps() []*regexp.Regexp {
+	sensitivePaths.RLock()
+	defer sensitivePaths.RUnlock()
+
+	regexps := make([]*regexp.Regexp, 0, len(sensitivePaths.keys))
+	for _, re := range sensitivePaths.keys {
+		regexps = append(regexps, re)
+	}
+	return regexps
+}
+
+// redactValue replaces the values of sensitive fields in a decoded JSON value.
+func redactValue(value interface{}, path []string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			itemPath := append(path[:len(path):len(path)], key)
+			if item != nil && isSensitivePath(itemPath) {
+				v[key] = RedactedValue
+			} else {
+				v[key] = redactValue(item, itemPath)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, path)
+		}
+	}
+	return value
+}
+
+// RedactJSON returns a JSON document with the values of sensitive fields
+// replaced. Documents that cannot be decoded are returned as is.
+func RedactJSON(data []byte) ([]byte, bool) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return data, false
+	}
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(value, nil)); err != nil {
+		return data, false
+	}
+	return bytes.TrimSuffix(out.Bytes(), []byte("\n")), true
+}
+
+var authorizationHeaderRegexp = regexp.MustCompile(`(?im)^(Authorization:[ \t]*)[^\r\n]*`)
+
+// RedactLog redacts sensitive values from an SDK log message argument, which
+// may be an HTTP request or response dump. JSON bodies are redacted field by
+// field; bodies that cannot be decoded, such as chunked responses, fall back
+// to redacting every string value of a sensitive key.
+func RedactLog(message string) string {
+	message = authorizationHeaderRegexp.ReplaceAllString(message, "${1}"+RedactedValue)
+
+	head, body := "", message
+	if i := strings.Index(message, "\r\n\r\n"); i >= 0 {
+		head, body = message[:i+4], message[i+4:]
+	}
+
+	trimmed := strings.TrimSpace(body)
+	if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		if redacted, ok := RedactJSON([]byte(trimmed)); ok {
+			return head + string(redacted)
+		}
+	}
+
+	for _, re := range sensitiveKeyRegexps() {
+		body = re.ReplaceAllString(body, `${1}"`+RedactedValue+`"`)
+	}
+	return head + body
+}
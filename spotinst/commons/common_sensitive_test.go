@@ -0,0 +1,134 @@
+package commons
+
+import (
+	"strings"
+	"testing"
+)
+
+func init() {
+	RegisterSensitivePaths("kubernetes.token", "login.password", "clientSecret")
+}
+
+func TestRedactJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		data     string
+		expected string
+		ok       bool
+	}{
+		{
+			name:     "top level key",
+			data:     `{"clientSecret":"s3cr3t","clientId":"id"}`,
+			expected: `{"clientId":"id","clientSecret":"<sensitive>"}`,
+			ok:       true,
+		},
+		{
+			name:     "nested key",
+			data:     `{"group":{"integration":{"kubernetes":{"token":"s3cr3t","server":"https://k8s"}}}}`,
+			expected: `{"group":{"integration":{"kubernetes":{"server":"https://k8s","token":"<sensitive>"}}}}`,
+			ok:       true,
+		},
+		{
+			name:     "key outside its path",
+			data:     `{"token":"t","password":"p"}`,
+			expected: `{"password":"p","token":"t"}`,
+			ok:       true,
+		},
+		{
+			name:     "arrays",
+			data:     `{"items":[{"login":{"password":"p1","userName":"a"}},{"login":{"password":"p2","userName":"b"}}]}`,
+			expected: `{"items":[{"login":{"password":"<sensitive>","userName":"a"}},{"login":{"password":"<sensitive>","userName":"b"}}]}`,
+			ok:       true,
+		},
+		{
+			name:     "null values",
+			data:     `{"clientSecret":null}`,
+			expected: `{"clientSecret":null}`,
+			ok:       true,
+		},
+		{
+			name:     "numbers and HTML characters",
+			data:     `{"capacity":12345678901234567890,"name":"<a&b>"}`,
+			expected: `{"capacity":12345678901234567890,"name":"<a&b>"}`,
+			ok:       true,
+		},
+		{
+			name:     "invalid",
+			data:     `{"clientSecret":`,
+			expected: `{"clientSecret":`,
+			ok:       false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			out, ok := RedactJSON([]byte(c.data))
+			if ok != c.ok {
+				t.Fatalf("expected ok %v, got %v", c.ok, ok)
+			}
+			if string(out) != c.expected {
+				t.Errorf("expected %s, got %s", c.expected, out)
+			}
+		})
+	}
+}
+
+func TestRedactLog(t *testing.T) {
+	cases := []struct {
+		name     string
+		message  string
+		expected string
+	}{
+		{
+			name:     "authorization header",
+			message:  "GET /aws/ec2/group HTTP/1.1\r\nHost: api.spotinst.io\r\nAuthorization: Bearer abc123\r\nAccept: application/json\r\n\r\n",
+			expected: "GET /aws/ec2/group HTTP/1.1\r\nHost: api.spotinst.io\r\nAuthorization: <sensitive>\r\nAccept: application/json\r\n\r\n",
+		},
+		{
+			name:     "lowercase authorization header",
+			message:  "authorization:Bearer abc123\r\n\r\n",
+			expected: "authorization:<sensitive>\r\n\r\n",
+		},
+		{
+			name:     "JSON body",
+			message:  "POST /azure/compute/v3/group HTTP/1.1\r\nAuthorization: Bearer abc123\r\n\r\n{\"group\":{\"compute\":{\"launchSpecification\":{\"login\":{\"password\":\"p\",\"userName\":\"u\"}}}}}\n",
+			expected: "POST /azure/compute/v3/group HTTP/1.1\r\nAuthorization: <sensitive>\r\n\r\n{\"group\":{\"compute\":{\"launchSpecification\":{\"login\":{\"password\":\"<sensitive>\",\"userName\":\"u\"}}}}}",
+		},
+		{
+			name:     "JSON array body",
+			message:  "HTTP/1.1 200 OK\r\n\r\n[{\"kubernetes\":{\"token\":\"t\"}}]",
+			expected: "HTTP/1.1 200 OK\r\n\r\n[{\"kubernetes\":{\"token\":\"<sensitive>\"}}]",
+		},
+		{
+			name:     "chunked body",
+			message:  "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n4a\r\n{\"items\":[{\"clientSecret\" : \"s\\\"3\",\"token\":\"t\"}]}\r\n0\r\n\r\n",
+			expected: "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n4a\r\n{\"items\":[{\"clientSecret\" : \"<sensitive>\",\"token\":\"<sensitive>\"}]}\r\n0\r\n\r\n",
+		},
+		{
+			name:     "plain message",
+			message:  "[DEBUG] Creating group",
+			expected: "[DEBUG] Creating group",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := RedactLog(c.message); got != c.expected {
+				t.Errorf("expected %q, got %q", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestRegisterSensitivePathsSharesKeys(t *testing.T) {
+	RegisterSensitivePaths("a.clientSecret", "b.clientSecret")
+	count := 0
+	for _, re := range sensitiveKeyRegexps() {
+		if strings.Contains(re.String(), `"clientSecret"`) {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("expected one regexp for clientSecret, got %d", count)
+	}
+}
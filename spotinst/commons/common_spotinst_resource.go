@@ -1,11 +1,16 @@
 package commons
 
 import (
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"math/big"
+	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
@@ -63,6 +68,7 @@ type GenericField struct {
 	onCreate         onFieldCreate
 	onUpdate         onFieldUpdate
 	hasChangeCustom  hasFieldChange
+	stateUpgraders   []fieldStateUpgrader
 }
 
 type GenericFields struct {
@@ -135,12 +141,20 @@ func (res *GenericResource) GetName() string {
 	return string(res.resourceName)
 }
 
+// ToJson returns the JSON representation of an API object for debug logging,
+// with the values of sensitive fields redacted.
 func ToJson(object interface{}) (string, error) {
-	if bytes, err := json.MarshalIndent(object, "", "  "); err != nil {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+	data, _ = RedactJSON(data)
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
 		return "", err
-	} else {
-		return string(bytes), nil
 	}
+	return out.String(), nil
 }
 
 // ErrCodeClusterHasNoActiveInstances is the Spotinst API error code returned
@@ -161,3 +175,65 @@ func ClusterHasNoActiveInstances(err error) bool {
 	}
 	return false
 }
+
+// IsNotFoundError reports whether an API error is a 404 response or carries
+// one of codes, which some services answer reads of missing resources with,
+// often with a 400 status. Other errors, e.g. 401 and 403 responses, are not
+// taken to mean that the resource is missing.
+func IsNotFoundError(err error, codes ...string) bool {
+	var errs client.Errors
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			if e.Response != nil && e.Response.StatusCode == http.StatusNotFound {
+				return true
+			}
+			if slices.Contains(codes, e.Code) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// OceanChildImportIDSeparator separates the Ocean cluster ID from the name of
+// a child resource (launch spec, virtual node group) in an import ID.
+const OceanChildImportIDSeparator = "/"
+
+// NamedResource is a minimal ID/name pair used to resolve import IDs that
+// reference a resource by name rather than by ID.
+type NamedResource struct {
+	ID   string
+	Name string
+}
+
+// ParseOceanChildImportID splits an import ID of the form
+// `<ocean_id>/<name>`. ok is false when the ID does not use that form and
+// should be imported as a plain resource ID.
+func ParseOceanChildImportID(id string) (oceanID, name string, ok bool) {
+	parts := strings.SplitN(id, OceanChildImportIDSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// ResolveIDByName returns the ID of the single resource named name. kind and
+// parentID are used for error reporting only.
+func ResolveIDByName(kind, parentID, name string, resources []NamedResource) (string, error) {
+	var ids []string
+	for _, r := range resources {
+		if r.Name == name {
+			ids = append(ids, r.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no %s named %q found in %q", kind, name, parentID)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d %ss named %q in %q (%s), import by ID instead",
+			len(ids), kind, name, parentID, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,35 @@
+package commons
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	codes := []string{"GROUP_DOESNT_EXIST", "CANT_GET_OCEAN_CLUSTER"}
+	cases := []struct {
+		name     string
+		err      error
+		codes    []string
+		expected bool
+	}{
+		{"404", client.Errors{{Response: &http.Response{StatusCode: http.StatusNotFound}}}, nil, true},
+		{"not found code", client.Errors{{Response: &http.Response{StatusCode: http.StatusBadRequest}, Code: "GROUP_DOESNT_EXIST"}}, codes, true},
+		{"code not given", client.Errors{{Response: &http.Response{StatusCode: http.StatusBadRequest}, Code: "GROUP_DOESNT_EXIST"}}, nil, false},
+		{"wrapped", fmt.Errorf("failed: %w", client.Errors{{Code: "CANT_GET_OCEAN_CLUSTER"}}), codes, true},
+		{"bad request", client.Errors{{Response: &http.Response{StatusCode: http.StatusBadRequest}, Code: "ValidationError"}}, codes, false},
+		{"unauthorized", client.Errors{{Response: &http.Response{StatusCode: http.StatusUnauthorized}}}, codes, false},
+		{"forbidden", client.Errors{{Response: &http.Response{StatusCode: http.StatusForbidden}}}, codes, false},
+		{"other error", fmt.Errorf("connection reset"), codes, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := IsNotFoundError(c.err, c.codes...); got != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
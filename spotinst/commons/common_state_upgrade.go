@@ -0,0 +1,191 @@
+package commons
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// FieldStateUpgradeFunc migrates the raw (JSON decoded) state of a resource
+// from one schema version to the next. It is registered on the field that
+// owns the attributes it touches and must only modify those attributes.
+type FieldStateUpgradeFunc func(rawState map[string]interface{}) error
+
+type fieldStateUpgrader struct {
+	version int
+	upgrade FieldStateUpgradeFunc
+}
+
+// AddStateUpgrader registers a migration of the field's state from schema
+// version `version` to version+1. The schema version of the resource is
+// derived from the highest version registered by any of its fields.
+func (field *GenericField) AddStateUpgrader(version int, upgrade FieldStateUpgradeFunc) *GenericField {
+	field.stateUpgraders = append(field.stateUpgraders, fieldStateUpgrader{
+		version: version,
+		upgrade: upgrade,
+	})
+	return field
+}
+
+// GetSchemaVersion returns the schema version of the resource, which is one
+// past the highest version any of its fields migrates from (0 if none does).
+func (res *GenericResource) GetSchemaVersion() int {
+	version := 0
+	if res.fields == nil {
+		return version
+	}
+	for _, field := range res.fields.fieldsMap {
+		for _, upgrader := range field.stateUpgraders {
+			if upgrader.version+1 > version {
+				version = upgrader.version + 1
+			}
+		}
+	}
+	return version
+}
+
+// GetStateUpgraders returns one state upgrader per schema version below the
+// current one, each running the migrations registered for that version by
+// the resource fields, in field name order.
+func (res *GenericResource) GetStateUpgraders() []schema.StateUpgrader {
+	version := res.GetSchemaVersion()
+	if version == 0 {
+		return nil
+	}
+
+	// The type is only used to decode legacy flatmap state. Fields are only
+	// ever added or deprecated between versions, so the current schema can
+	// decode the state of all previous versions.
+	stateType := (&schema.Resource{Schema: res.GetSchemaMap()}).CoreConfigSchema().ImpliedType()
+
+	names := make([]string, 0, len(res.fields.fieldsMap))
+	for name := range res.fields.fieldsMap {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	upgraders := make([]schema.StateUpgrader, version)
+	for v := 0; v < version; v++ {
+		var upgrades []FieldStateUpgradeFunc
+		for _, name := range names {
+			for _, upgrader := range res.fields.fieldsMap[FieldName(name)].stateUpgraders {
+				if upgrader.version == v {
+					upgrades = append(upgrades, upgrader.upgrade)
+				}
+			}
+		}
+
+		from := v
+		upgraders[v] = schema.StateUpgrader{
+			Version: from,
+			Type:    stateType,
+			Upgrade: func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+				if rawState == nil {
+					return rawState, nil
+				}
+				log.Printf("[INFO] Upgrading %s state from schema version %d to %d", res.GetName(), from, from+1)
+				for _, upgrade := range upgrades {
+					if err := upgrade(rawState); err != nil {
+						return nil, fmt.Errorf("failed to upgrade %s state from schema version %d: %v", res.GetName(), from, err)
+					}
+				}
+				return rawState, nil
+			},
+		}
+	}
+	return upgraders
+}
+
+// StateBlocks returns the blocks of a nested block attribute in raw state.
+func StateBlocks(rawState map[string]interface{}, key string) []map[string]interface{} {
+	list, _ := rawState[key].([]interface{})
+	blocks := make([]map[string]interface{}, 0, len(list))
+	for _, item := range list {
+		if block, ok := item.(map[string]interface{}); ok {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// CopyStateAttribute copies the value of a deprecated attribute into the
+// attribute that replaces it, unless the latter is already set. The
+// deprecated attribute is kept, so configurations that still use it don't
+// produce a diff.
+func CopyStateAttribute(rawState map[string]interface{}, from, to string) {
+	if isEmptyStateValue(rawState[to]) && !isEmptyStateValue(rawState[from]) {
+		rawState[to] = rawState[from]
+	}
+}
+
+func isEmptyStateValue(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case float64:
+		return v == 0
+	}
+	return false
+}
+
+// SuppressDeprecatedAttributeDiff suppresses the removal of an attribute
+// from the configuration when its sibling attribute `other` (the deprecated
+// or the replacing one) carries the same value, which is the case after a
+// state upgrade copied the deprecated value over. Only attributes that are
+// absent from the configuration count as removed, so setting one explicitly
+// to its zero value still produces a diff.
+func SuppressDeprecatedAttributeDiff(other string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if old == "" || !isConfigValueNull(d.GetRawConfig(), k, new) {
+			return false
+		}
+		sibling := other
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			sibling = k[:i+1] + other
+		}
+		return fmt.Sprint(d.Get(sibling)) == old
+	}
+}
+
+// isConfigValueNull reports whether the attribute at the flatmap key k is
+// absent from config. The configuration is always available when planning;
+// without it, an empty or zero new value is taken as absent.
+func isConfigValueNull(config cty.Value, k, new string) bool {
+	if config.IsNull() {
+		return new == "" || new == "0"
+	}
+
+	v := config
+	for _, part := range strings.Split(k, ".") {
+		if v.IsNull() {
+			return true
+		}
+		if !v.IsKnown() {
+			return false
+		}
+		switch t := v.Type(); {
+		case t.IsObjectType():
+			if !t.HasAttribute(part) {
+				return true
+			}
+			v = v.GetAttr(part)
+		case t.IsListType() || t.IsTupleType():
+			i, err := strconv.Atoi(part)
+			if err != nil || i < 0 || i >= v.LengthInt() {
+				return true
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+		default:
+			return false
+		}
+	}
+	return v.IsNull()
+}
@@ -0,0 +1,210 @@
+package commons
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testStateUpgradeResource(fields ...*GenericField) *GenericResource {
+	fieldsMap := make(map[FieldName]*GenericField, len(fields))
+	for _, field := range fields {
+		fieldsMap[field.fieldName] = field
+	}
+	return &GenericResource{resourceName: "spotinst_test", fields: NewGenericFields(fieldsMap)}
+}
+
+func testStateUpgradeField(name FieldName) *GenericField {
+	return NewGenericField("test", name, &schema.Schema{Type: schema.TypeString, Optional: true}, nil, nil, nil, nil)
+}
+
+// testAppendStateUpgrade appends step to the "steps" attribute of the state,
+// so tests can check which migrations ran and in which order.
+func testAppendStateUpgrade(step string) FieldStateUpgradeFunc {
+	return func(rawState map[string]interface{}) error {
+		steps, _ := rawState["steps"].(string)
+		rawState["steps"] = strings.TrimPrefix(steps+","+step, ",")
+		return nil
+	}
+}
+
+func TestGetStateUpgraders(t *testing.T) {
+	res := testStateUpgradeResource(
+		testStateUpgradeField("b").
+			AddStateUpgrader(0, testAppendStateUpgrade("b0")).
+			AddStateUpgrader(1, testAppendStateUpgrade("b1")),
+		testStateUpgradeField("a").
+			AddStateUpgrader(0, testAppendStateUpgrade("a0")),
+		testStateUpgradeField("steps"),
+	)
+
+	if v := res.GetSchemaVersion(); v != 2 {
+		t.Fatalf("expected schema version 2, got %d", v)
+	}
+	upgraders := res.GetStateUpgraders()
+	if len(upgraders) != 2 {
+		t.Fatalf("expected 2 upgraders, got %d", len(upgraders))
+	}
+
+	rawState := map[string]interface{}{"id": "r-1234", "a": "x"}
+	for i, upgrader := range upgraders {
+		if upgrader.Version != i {
+			t.Errorf("expected upgrader %d to upgrade version %d, got %d", i, i, upgrader.Version)
+		}
+		if upgrader.Type == cty.NilType || !upgrader.Type.IsObjectType() {
+			t.Errorf("expected upgrader %d to have an object type, got %#v", i, upgrader.Type)
+		}
+		var err error
+		if rawState, err = upgrader.Upgrade(context.Background(), rawState, nil); err != nil {
+			t.Fatalf("upgrader %d: %v", i, err)
+		}
+	}
+
+	expected := map[string]interface{}{"id": "r-1234", "a": "x", "steps": "a0,b0,b1"}
+	if !reflect.DeepEqual(rawState, expected) {
+		t.Errorf("expected %v, got %v", expected, rawState)
+	}
+}
+
+func TestGetStateUpgradersNone(t *testing.T) {
+	res := testStateUpgradeResource(testStateUpgradeField("a"))
+	if v := res.GetSchemaVersion(); v != 0 {
+		t.Errorf("expected schema version 0, got %d", v)
+	}
+	if upgraders := res.GetStateUpgraders(); upgraders != nil {
+		t.Errorf("expected no upgraders, got %d", len(upgraders))
+	}
+}
+
+func TestGetStateUpgradersError(t *testing.T) {
+	res := testStateUpgradeResource(testStateUpgradeField("a").
+		AddStateUpgrader(0, func(rawState map[string]interface{}) error {
+			return fmt.Errorf("bad state")
+		}))
+
+	upgraders := res.GetStateUpgraders()
+	_, err := upgraders[0].Upgrade(context.Background(), map[string]interface{}{"a": "x"}, nil)
+	if err == nil || err.Error() != "failed to upgrade spotinst_test state from schema version 0: bad state" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	rawState, err := upgraders[0].Upgrade(context.Background(), nil, nil)
+	if err != nil || rawState != nil {
+		t.Errorf("expected nil state to be left alone, got %v, %v", rawState, err)
+	}
+}
+
+func TestCopyStateAttribute(t *testing.T) {
+	cases := []struct {
+		name     string
+		state    map[string]interface{}
+		expected interface{}
+	}{
+		{"copied", map[string]interface{}{"old": "v"}, "v"},
+		{"copied over an empty value", map[string]interface{}{"old": float64(5), "new": float64(0)}, float64(5)},
+		{"kept", map[string]interface{}{"old": "v", "new": "w"}, "w"},
+		{"nothing to copy", map[string]interface{}{"old": "", "new": nil}, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			CopyStateAttribute(c.state, "old", "new")
+			if c.state["new"] != c.expected {
+				t.Errorf("expected %#v, got %#v", c.expected, c.state["new"])
+			}
+			if _, ok := c.state["old"]; !ok {
+				t.Errorf("expected the deprecated attribute to be kept")
+			}
+		})
+	}
+}
+
+func TestSuppressDeprecatedAttributeDiff(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"check": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout":  {Type: schema.TypeInt, Optional: true, DiffSuppressFunc: SuppressDeprecatedAttributeDiff("time_out")},
+						"time_out": {Type: schema.TypeInt, Optional: true, DiffSuppressFunc: SuppressDeprecatedAttributeDiff("timeout")},
+					},
+				},
+			},
+		},
+	}
+
+	// The state after an upgrade copied time_out to timeout.
+	state := &terraform.InstanceState{
+		ID: "hc-1234",
+		Attributes: map[string]string{
+			"id":               "hc-1234",
+			"check.#":          "1",
+			"check.0.timeout":  "10",
+			"check.0.time_out": "10",
+		},
+	}
+
+	cases := []struct {
+		name    string
+		check   map[string]interface{}
+		changed []string
+	}{
+		{
+			name:  "config sets the deprecated attribute",
+			check: map[string]interface{}{"time_out": 10},
+		},
+		{
+			name:  "config sets the replacing attribute",
+			check: map[string]interface{}{"timeout": 10},
+		},
+		{
+			name:    "config sets the replacing attribute to zero",
+			check:   map[string]interface{}{"timeout": 0},
+			changed: []string{"check.0.time_out", "check.0.timeout"},
+		},
+		{
+			name:    "config changes the value",
+			check:   map[string]interface{}{"timeout": 20},
+			changed: []string{"check.0.time_out", "check.0.timeout"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			attrs := map[string]cty.Value{"timeout": cty.NullVal(cty.Number), "time_out": cty.NullVal(cty.Number)}
+			for k, v := range c.check {
+				attrs[k] = cty.NumberIntVal(int64(v.(int)))
+			}
+			configVal := cty.ObjectVal(map[string]cty.Value{
+				"id":    cty.NullVal(cty.String),
+				"check": cty.ListVal([]cty.Value{cty.ObjectVal(attrs)}),
+			})
+
+			// The plugin server passes the raw configuration in the prior state.
+			priorState := state.DeepCopy()
+			priorState.RawConfig = configVal
+			diff, err := res.Diff(context.Background(), priorState, terraform.NewResourceConfigShimmed(configVal, res.CoreConfigSchema()), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var changed []string
+			if diff != nil {
+				for k := range diff.Attributes {
+					changed = append(changed, k)
+				}
+			}
+			sort.Strings(changed)
+			if strings.Join(changed, ",") != strings.Join(c.changed, ",") {
+				t.Errorf("expected changes to %v, got %v", c.changed, changed)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package commons
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Values accepted by the API for fields shared by several AWS resources.
+var (
+	AWSProducts = []string{
+		"Linux/UNIX",
+		"Linux/UNIX (Amazon VPC)",
+		"SUSE Linux",
+		"SUSE Linux (Amazon VPC)",
+		"Windows",
+		"Windows (Amazon VPC)",
+		"Red Hat Enterprise Linux",
+		"Red Hat Enterprise Linux (Amazon VPC)",
+	}
+
+	AWSVolumeTypes = []string{"standard", "gp2", "gp3", "io1", "io2", "st1", "sc1"}
+
+	AWSHealthCheckTypes = []string{
+		"ELB",
+		"HCS",
+		"TARGET_GROUP",
+		"MLB",
+		"MLB_RUNTIME",
+		"MULTAI_TARGET_SET",
+		"CUSTOM",
+		"EC2",
+		"K8S_NODE",
+		"NOMAD_NODE",
+		"ECS_CLUSTER_INSTANCE",
+		"NONE",
+	}
+
+	OceanOrientations = []string{"balanced", "costOriented", "cheapest"}
+)
+
+var (
+	subnetIDRegex = regexp.MustCompile(`^subnet-[0-9a-f]+$`)
+	arnRegex      = regexp.MustCompile(`^arn:aws[a-zA-Z-]*:[a-z0-9-]+:[a-z0-9-]*:(\d{12})?:.+$`)
+)
+
+// ValidateSubnetID validates an AWS subnet ID.
+func ValidateSubnetID(i interface{}, k string) ([]string, []error) {
+	return validateStringMatch(i, k, subnetIDRegex, "an AWS subnet ID (subnet-...)")
+}
+
+// ValidateARN validates an AWS ARN.
+func ValidateARN(i interface{}, k string) ([]string, []error) {
+	return validateStringMatch(i, k, arnRegex, "an AWS ARN")
+}
+
+func validateStringMatch(i interface{}, k string, r *regexp.Regexp, what string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if !r.MatchString(v) {
+		return nil, []error{fmt.Errorf("expected %s to be %s, got %q", k, what, v)}
+	}
+	return nil, nil
+}
+
+// cronField describes the values allowed in one field of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // names of min, min+1, ... (e.g. JAN, FEB)
+	special  *regexp.Regexp
+}
+
+var (
+	cronMonths = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+	cronSecond = cronField{name: "second", min: 0, max: 59}
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: cronMonths}
+	cronYear   = cronField{name: "year", min: 1970, max: 2199}
+
+	// Unix day fields; "?" is tolerated since the API accepts it.
+	cronDayOfMonth = cronField{name: "day-of-month", min: 1, max: 31, special: regexp.MustCompile(`^\?$`)}
+	cronDayOfWeek  = cronField{name: "day-of-week", min: 0, max: 7,
+		names:   []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		special: regexp.MustCompile(`^\?$`)}
+
+	// Quartz day fields, with support for L, W and #.
+	cronQuartzDayOfMonth = cronField{name: "day-of-month", min: 1, max: 31,
+		special: regexp.MustCompile(`^(\?|L|LW|L-([0-9]|[12][0-9]|30)|([1-9]|[12][0-9]|3[01])W)$`)}
+	cronQuartzDayOfWeek = cronField{name: "day-of-week", min: 1, max: 7,
+		names:   []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"},
+		special: regexp.MustCompile(`^(\?|L|([1-7]|[A-Z]{3})(L|#[1-5]))$`)}
+)
+
+// ParseCronExpression checks that expr is either a Unix cron expression
+// (minute hour day-of-month month day-of-week) or a Quartz expression with
+// a leading seconds field and an optional trailing year field.
+func ParseCronExpression(expr string) error {
+	parts := strings.Fields(expr)
+
+	var fields []cronField
+	switch len(parts) {
+	case 5:
+		fields = []cronField{cronMinute, cronHour, cronDayOfMonth, cronMonth, cronDayOfWeek}
+	case 6:
+		fields = []cronField{cronSecond, cronMinute, cronHour, cronQuartzDayOfMonth, cronMonth, cronQuartzDayOfWeek}
+	case 7:
+		fields = []cronField{cronSecond, cronMinute, cronHour, cronQuartzDayOfMonth, cronMonth, cronQuartzDayOfWeek, cronYear}
+	default:
+		return fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(parts))
+	}
+
+	for i, part := range parts {
+		if err := fields[i].parse(strings.ToUpper(part)); err != nil {
+			return fmt.Errorf("%s field %q: %v", fields[i].name, part, err)
+		}
+	}
+	return nil
+}
+
+func (f cronField) parse(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		if item == "" {
+			return fmt.Errorf("empty list item")
+		}
+		if f.special != nil && f.special.MatchString(item) {
+			continue
+		}
+
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("invalid step %q", step)
+			}
+		}
+		if base == "*" {
+			continue
+		}
+
+		lo, hi, isRange := strings.Cut(base, "-")
+		from, err := f.value(lo)
+		if err != nil {
+			return err
+		}
+		if isRange {
+			to, err := f.value(hi)
+			if err != nil {
+				return err
+			}
+			if to < from {
+				return fmt.Errorf("range %q is reversed", base)
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if s == name {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", n, f.min, f.max)
+	}
+	return n, nil
+}
+
+// ValidateCronExpression is a schema.SchemaValidateFunc for cron
+// expressions. An empty string is accepted, for fields where the cron
+// expression is optional and an alternative (e.g. frequency) is used.
+func ValidateCronExpression(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if v == "" {
+		return nil, nil
+	}
+	if err := ParseCronExpression(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid cron expression, got %q: %v", k, v, err)}
+	}
+	return nil, nil
+}
+
+// TimeWindow is a weekly window in the format "Ddd:HH:MM-Ddd:HH:MM",
+// e.g. "Mon:03:00-Wed:02:20", as used by time_windows and shutdown_hours.
+type TimeWindow struct {
+	StartDay  time.Weekday
+	StartTime time.Duration // since midnight
+	EndDay    time.Weekday
+	EndTime   time.Duration // since midnight
+}
+
+var (
+	timeWindowRegex = regexp.MustCompile(`^([A-Za-z]{3}):(\d{2}):(\d{2})-([A-Za-z]{3}):(\d{2}):(\d{2})$`)
+	timeWindowDays  = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// ParseTimeWindow parses a time window in the format "Ddd:HH:MM-Ddd:HH:MM".
+func ParseTimeWindow(s string) (*TimeWindow, error) {
+	m := timeWindowRegex.FindStringSubmatch(s)
+	if m == nil {
+		return nil, fmt.Errorf("expected the format Ddd:HH:MM-Ddd:HH:MM (e.g. Mon:03:00-Wed:02:20)")
+	}
+
+	startDay, startTime, err := parseTimeWindowPoint(m[1], m[2], m[3])
+	if err != nil {
+		return nil, err
+	}
+	endDay, endTime, err := parseTimeWindowPoint(m[4], m[5], m[6])
+	if err != nil {
+		return nil, err
+	}
+	if startDay == endDay && startTime == endTime {
+		return nil, fmt.Errorf("start and end are equal")
+	}
+
+	return &TimeWindow{
+		StartDay:  startDay,
+		StartTime: startTime,
+		EndDay:    endDay,
+		EndTime:   endTime,
+	}, nil
+}
+
+func parseTimeWindowPoint(day, hour, minute string) (time.Weekday, time.Duration, error) {
+	d := -1
+	for i, name := range timeWindowDays {
+		if strings.EqualFold(day, name) {
+			d = i
+		}
+	}
+	if d < 0 {
+		return 0, 0, fmt.Errorf("invalid day %q, expected one of Sun, Mon, Tue, Wed, Thu, Fri, Sat", day)
+	}
+
+	h, _ := strconv.Atoi(hour)
+	m, _ := strconv.Atoi(minute)
+	if h > 23 || m > 59 {
+		return 0, 0, fmt.Errorf("invalid time %s:%s", hour, minute)
+	}
+
+	return time.Weekday(d), time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// ValidateTimeWindow is a schema.SchemaValidateFunc for time windows.
+func ValidateTimeWindow(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := ParseTimeWindow(v); err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a valid time window, got %q: %v", k, v, err)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,128 @@
+package commons
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	cases := []struct {
+		expr string
+		err  string
+	}{
+		// Unix.
+		{"0 1 * * *", ""},
+		{"*/15 0-6,22-23 1,15 * 1-5", ""},
+		{"0 0 ? * 7", ""},
+		{"30 2 * JAN,jul MON-FRI", ""},
+		{"0 0 1 JAN-DEC SUN", ""},
+		{"0 1 * *", "expected 5 fields"},
+		{"0 1 * * * * * *", "expected 5 fields"},
+		{"60 1 * * *", "minute field \"60\": value 60 out of range [0, 59]"},
+		{"0 24 * * *", "hour field"},
+		{"0 0 0 * *", "day-of-month field"},
+		{"0 0 * 13 *", "month field"},
+		{"0 0 * * 8", "day-of-week field"},
+		{"0 0 L * *", "day-of-month field \"L\": invalid value \"L\""},
+		{"0 0 * * 5L", "day-of-week field"},
+		{"*/0 0 * * *", "invalid step \"0\""},
+		{"0 0 1,,2 * *", "empty list item"},
+		{"0 0 * FOO *", "invalid value \"FOO\""},
+
+		// Reversed ranges.
+		{"0 6-2 * * *", "range \"6-2\" is reversed"},
+		{"0 0 * DEC-JAN *", "range \"DEC-JAN\" is reversed"},
+		{"0 0 * * FRI-MON", "range \"FRI-MON\" is reversed"},
+
+		// Quartz.
+		{"0 0 12 * * ?", ""},
+		{"0 15 10 ? * MON-FRI", ""},
+		{"0 0 0 L * ?", ""},
+		{"0 0 0 LW * ?", ""},
+		{"0 0 0 L-3 * ?", ""},
+		{"0 0 0 15W * ?", ""},
+		{"0 0 0 ? * 6L", ""},
+		{"0 0 0 ? * FRIL", ""},
+		{"0 0 0 ? * 6#3", ""},
+		{"0 0 0 ? * MON#1", ""},
+		{"0 0 0 ? * L", ""},
+		{"0 0 12 1 JAN ? 2030", ""},
+		{"0 0 12 1 1 ? 2030-2035", ""},
+		{"60 0 12 * * ?", "second field"},
+		{"0 0 0 ? * 0", "day-of-week field \"0\": value 0 out of range [1, 7]"},
+		{"0 0 0 ? * 6#6", "day-of-week field"},
+		{"0 0 0 32W * ?", "day-of-month field"},
+		{"0 0 0 0W * ?", "day-of-month field"},
+		{"0 0 0 L-31 * ?", "day-of-month field"},
+		{"0 0 0 ? * 7-1", "range \"7-1\" is reversed"},
+		{"0 0 12 1 1 ? 2200", "year field"},
+		{"0 0 12 1 1 ? 2035-2030", "range \"2035-2030\" is reversed"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			err := ParseCronExpression(c.expr)
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.err) {
+				t.Errorf("expected error containing %q, got %v", c.err, err)
+			}
+		})
+	}
+}
+
+func TestParseTimeWindow(t *testing.T) {
+	cases := []struct {
+		window   string
+		expected *TimeWindow
+		err      string
+	}{
+		{
+			window:   "Mon:03:00-Wed:02:20",
+			expected: &TimeWindow{StartDay: time.Monday, StartTime: 3 * time.Hour, EndDay: time.Wednesday, EndTime: 2*time.Hour + 20*time.Minute},
+		},
+		{
+			window:   "sat:23:59-SUN:00:00",
+			expected: &TimeWindow{StartDay: time.Saturday, StartTime: 23*time.Hour + 59*time.Minute, EndDay: time.Sunday},
+		},
+		{
+			window:   "Fri:18:00-Mon:06:00",
+			expected: &TimeWindow{StartDay: time.Friday, StartTime: 18 * time.Hour, EndDay: time.Monday, EndTime: 6 * time.Hour},
+		},
+		{
+			window:   "Tue:10:00-Tue:09:00",
+			expected: &TimeWindow{StartDay: time.Tuesday, StartTime: 10 * time.Hour, EndDay: time.Tuesday, EndTime: 9 * time.Hour},
+		},
+		{window: "Mon:3:00-Wed:02:20", err: "expected the format"},
+		{window: "Mon:03:00 - Wed:02:20", err: "expected the format"},
+		{window: "Monday:03:00-Wed:02:20", err: "expected the format"},
+		{window: "Mon:03:00", err: "expected the format"},
+		{window: "Foo:03:00-Wed:02:20", err: "invalid day \"Foo\""},
+		{window: "Mon:24:00-Wed:02:20", err: "invalid time 24:00"},
+		{window: "Mon:03:00-Wed:02:60", err: "invalid time 02:60"},
+		{window: "Mon:03:00-mon:03:00", err: "start and end are equal"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.window, func(t *testing.T) {
+			w, err := ParseTimeWindow(c.window)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Errorf("expected error containing %q, got %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if *w != *c.expected {
+				t.Errorf("expected %+v, got %+v", *c.expected, *w)
+			}
+		})
+	}
+}
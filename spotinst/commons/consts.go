@@ -32,6 +32,8 @@ const (
 
 	OceanAWSLaunchSpec ResourceAffinity = "Ocean_AWS_Launch_Spec"
 
+	OceanAWSClusterScheduledTask ResourceAffinity = "Ocean_AWS_Cluster_Scheduled_Task"
+
 	OceanAWSExtendedResourceDefinition ResourceAffinity = "Ocean_AWS_Extended_Resource_Definition"
 
 	OceanRightSizingRule ResourceAffinity = "Ocean_Right_Sizing_Rule"
@@ -75,6 +77,9 @@ const (
 	ElastigroupAWSIntegrations        ResourceAffinity = "Elastigroup_AWS_Integrations"
 	ElastigroupAwsLogging             ResourceAffinity = "Elastigroup_AWS_Logging"
 
+	ElastigroupAWSGroupScheduledTask ResourceAffinity = "Elastigroup_AWS_Group_Scheduled_Task"
+	ElastigroupAWSGroupScalingPolicy ResourceAffinity = "Elastigroup_AWS_Group_Scaling_Policy"
+
 	ManagedInstanceAWS                    ResourceAffinity = "Managed_Instance_AWS"
 	ManagedInstanceAWSStrategy            ResourceAffinity = "Managed_Instance_AWS_Strategy"
 	ManagedInstanceAWSPersistence         ResourceAffinity = "Managed_Instance_AWS_Persistence"
@@ -86,10 +91,12 @@ const (
 	ManagedInstanceAWSScheduling          ResourceAffinity = "Managed_Instance_AWS_Scheduling"
 	ManagedInstanceAWSComputeInstanceType ResourceAffinity = "Managed_Instance_AWS_Compute_Instance_Type"
 
-	OrganizationUser             ResourceAffinity = "Organization_User"
-	OrganizationPolicy           ResourceAffinity = "Organization_Policy"
-	OrganizationProgrammaticUser ResourceAffinity = "Organization_Progammatic_User"
-	OrganizationUserGroup        ResourceAffinity = "Organization_User_Group"
+	OrganizationUser                ResourceAffinity = "Organization_User"
+	OrganizationPolicy              ResourceAffinity = "Organization_Policy"
+	OrganizationProgrammaticUser    ResourceAffinity = "Organization_Progammatic_User"
+	OrganizationUserGroup           ResourceAffinity = "Organization_User_Group"
+	OrganizationUserGroupMembership ResourceAffinity = "Organization_User_Group_Membership"
+	OrganizationPolicyAttachment    ResourceAffinity = "Organization_Policy_Attachment"
 
 	ElastigroupGCP                    ResourceAffinity = "Elastigroup_GCP"
 	ElastigroupGCPDisk                ResourceAffinity = "Elastigroup_GCP_Disk"
@@ -172,6 +179,7 @@ const (
 	CredentialsGCP                               ResourceAffinity = "Credentials_GCP"
 	CredentialsAzure                             ResourceAffinity = "Credentials_Azure"
 	Account                                      ResourceAffinity = "Account"
+	AccountOnboarding                            ResourceAffinity = "Account_Onboarding"
 
 	OceanCDVerificationProvider           ResourceAffinity = "OceanCD_Verification_Provider"
 	OceanCDVerificationProviderCloudWatch ResourceAffinity = "OceanCD_Verification_Provider_Cloud_Watch"
@@ -204,4 +212,6 @@ const (
 	ResourceOnUpdate LogFormat = "onUpdate() -> %s -> started for %s..."
 	ResourceOnRead   LogFormat = "onRead() -> %s -> started for %s..."
 	ResourceOnCreate LogFormat = "onCreate() -> %s -> started..."
+
+	DataSourceOnRead LogFormat = "onRead() -> %s -> started..."
 )
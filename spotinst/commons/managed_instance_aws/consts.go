@@ -11,6 +11,28 @@ const (
 	ManagedInstanceAction commons.FieldName = "managed_instance_action"
 	ActionType            commons.FieldName = "type"
 	// ----------------------------------------
+
+	WaitForState        commons.FieldName = "wait_for_state"
+	WaitForStateTimeout commons.FieldName = "wait_for_state_timeout"
+
+	// - Runtime Status -----------------------
+	InstanceID  commons.FieldName = "instance_id"
+	PrivateIP   commons.FieldName = "private_ip"
+	PublicIP    commons.FieldName = "public_ip"
+	IPv6Address commons.FieldName = "ipv6_address"
+	State       commons.FieldName = "state"
+	// ----------------------------------------
+)
+
+const (
+	StateActive string = "ACTIVE"
+	StatePaused string = "PAUSED"
+
+	// A managed instance in one of these states does not become active or
+	// paused without another action.
+	StateError        string = "ERROR"
+	StateDeallocating string = "DEALLOCATING"
+	StateDeallocated  string = "DEALLOCATED"
 )
 
 const (
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
@@ -143,6 +144,78 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[WaitForState] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		WaitForState,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{StateActive, StatePaused}, false),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[WaitForStateTimeout] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		WaitForStateTimeout,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[InstanceID] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		InstanceID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[PrivateIP] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		PrivateIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[PublicIP] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		PublicIP,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[IPv6Address] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		IPv6Address,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
+	fieldsMap[State] = commons.NewGenericField(
+		commons.ManagedInstanceAWS,
+		State,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		nil, nil, nil, nil,
+	)
+
 	fieldsMap[Delete] = commons.NewGenericField(
 		commons.ManagedInstanceAWS,
 		Delete,
@@ -14,8 +14,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ManagedInstanceAWSCompute,
 		SubnetIDs,
 		&schema.Schema{
-			Type:     schema.TypeList,
-			Elem:     &schema.Schema{Type: schema.TypeString},
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commons.ValidateSubnetID,
+			},
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
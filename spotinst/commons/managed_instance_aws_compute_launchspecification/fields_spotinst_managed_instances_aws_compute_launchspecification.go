@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -589,9 +590,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(VolumeType): {
-									Type:     schema.TypeString,
-									Optional: true,
-									Computed: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									Computed:     true,
+									ValidateFunc: validation.StringInSlice(commons.AWSVolumeTypes, true),
 								},
 
 								string(Throughput): {
@@ -748,13 +750,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(HttpTokens): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"optional", "required"}, false),
 					},
 
 					string(HttpPutResponseHopLimit): {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 64),
 					},
 
 					string(InstanceMetadataTags): {
@@ -28,6 +28,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst/featureflag"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/log"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/version"
 )
 
@@ -111,6 +112,11 @@ func (c *Config) getSession() (*session.Session, error) {
 	// Logging.
 	{
 		config.WithLogger(log.LoggerFunc(func(format string, args ...interface{}) {
+			for i, arg := range args {
+				if s, ok := arg.(string); ok {
+					args[i] = commons.RedactLog(s)
+				}
+			}
 			stdlog.Printf(fmt.Sprintf("[DEBUG] [spotinst-sdk-go] %s", format), args...)
 		}))
 	}
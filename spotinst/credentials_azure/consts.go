@@ -9,4 +9,10 @@ const (
 	TenantId       commons.FieldName = "tenant_id"
 	SubscriptionId commons.FieldName = "subscription_id"
 	ExpirationDate commons.FieldName = "expiration_date"
+
+	DaysUntilExpiration commons.FieldName = "days_until_expiration"
 )
+
+// ExpirationWarningDays is how many days before the client secret expires
+// plans start warning about it.
+const ExpirationWarningDays = 30
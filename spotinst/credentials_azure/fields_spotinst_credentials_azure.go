@@ -2,13 +2,19 @@ package credentials_azure
 
 import (
 	"fmt"
+	"math"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	commons.RegisterSensitivePaths("clientSecret")
+
 	fieldsMap[AccountId] = commons.NewGenericField(
 		commons.CredentialsAzure,
 		AccountId,
@@ -81,9 +87,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.CredentialsAzure,
 		ClientSecret,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:      schema.TypeString,
+			Sensitive: true,
+			Required:  true,
+			ForceNew:  true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			credentialsWrapper := resourceObject.(*commons.AzureCredentialsWrapper)
@@ -184,10 +191,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.CredentialsAzure,
 		ExpirationDate,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			ForceNew: true,
-			Default:  -1,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			Default:          -1,
+			ValidateDiagFunc: validateExpirationDate,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			credentialsWrapper := resourceObject.(*commons.AzureCredentialsWrapper)
@@ -216,4 +224,75 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 	)
 
+	fieldsMap[DaysUntilExpiration] = commons.NewGenericField(
+		commons.CredentialsAzure,
+		DaysUntilExpiration,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			credentialsWrapper := resourceObject.(*commons.AzureCredentialsWrapper)
+			credentials := credentialsWrapper.GetCredentials()
+			date := resourceData.Get(string(ExpirationDate)).(string)
+			if credentials.ExpirationDate != nil {
+				date = spotinst.StringValue(credentials.ExpirationDate)
+			}
+			var value *int = nil
+			if days, ok := daysUntilExpiration(date, time.Now()); ok {
+				value = spotinst.Int(days)
+			}
+			if err := resourceData.Set(string(DaysUntilExpiration), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(DaysUntilExpiration), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+}
+
+// expirationDateLayouts are the accepted formats of expiration_date.
+var expirationDateLayouts = []string{time.RFC3339, "2006-01-02"}
+
+// daysUntilExpiration returns the number of whole days left until the
+// expiration date, negative once it has passed. It reports false when the
+// date is unset or cannot be parsed.
+func daysUntilExpiration(date string, now time.Time) (int, bool) {
+	for _, layout := range expirationDateLayouts {
+		if expiresAt, err := time.Parse(layout, date); err == nil {
+			return int(math.Floor(expiresAt.Sub(now).Hours() / 24)), true
+		}
+	}
+	return 0, false
+}
+
+// validateExpirationDate warns at plan time when the client secret expires
+// within ExpirationWarningDays or has already expired.
+func validateExpirationDate(v interface{}, path cty.Path) diag.Diagnostics {
+	days, ok := daysUntilExpiration(v.(string), time.Now())
+	if !ok || days > ExpirationWarningDays {
+		return nil
+	}
+
+	summary := fmt.Sprintf("Azure client secret expires in %d days", days)
+	detail := fmt.Sprintf("The client secret expires on %s. Create a new secret for the app registration and update client_secret and expiration_date before then, or Spot will no longer be able to manage resources in the subscription.", v)
+	if days < 0 {
+		summary = "Azure client secret has expired"
+		detail = fmt.Sprintf("The client secret expired on %s. Create a new secret for the app registration and update client_secret and expiration_date so that Spot can manage resources in the subscription again.", v)
+	}
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       summary,
+			Detail:        detail,
+			AttributePath: path,
+		},
+	}
 }
@@ -9,6 +9,8 @@ import (
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+	commons.RegisterSensitivePaths("private_key")
+
 	fieldsMap[AccountId] = commons.NewGenericField(
 		commons.CredentialsGCP,
 		AccountId,
@@ -149,9 +151,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.CredentialsGCP,
 		PrivateKey,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:      schema.TypeString,
+			Sensitive: true,
+			Required:  true,
+			ForceNew:  true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			credentialsWrapper := resourceObject.(*commons.GCPCredentialsWrapper)
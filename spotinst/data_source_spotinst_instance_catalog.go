@@ -0,0 +1,147 @@
+package spotinst
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
+)
+
+// Attributes shared by the data sources backed by the instance type catalog.
+const (
+	catalogNames        = "names"
+	catalogUpdatedAt    = "catalog_updated_at"
+	catalogComplete     = "catalog_complete"
+	catalogName         = "name"
+	catalogFamily       = "family"
+	catalogCategory     = "category"
+	catalogArchitecture = "architecture"
+	catalogVCPU         = "vcpu"
+	catalogMemoryGiB    = "memory_gib"
+	catalogGPU          = "gpu"
+	catalogGPUType      = "gpu_type"
+	catalogSpot         = "spot_available"
+)
+
+// catalogDataSourceSchema returns the computed attributes of a catalog data
+// source, with the matching types exported under listField.
+func catalogDataSourceSchema(listField string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		catalogNames: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+
+		catalogUpdatedAt: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		catalogComplete: {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+
+		listField: {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					catalogName: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					catalogFamily: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					catalogCategory: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					catalogArchitecture: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					catalogVCPU: {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+
+					catalogMemoryGiB: {
+						Type:     schema.TypeFloat,
+						Computed: true,
+					},
+
+					catalogGPU: {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+
+					catalogGPUType: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+
+					catalogSpot: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// setCatalogDataSource stores the catalog types with the given names in the
+// data source. Spot availability is "true", "false" or, for the types whose
+// catalog entry does not record it, "unknown". The ID identifies the result, so that it changes along with
+// the catalog or the filters.
+func setCatalogDataSource(resourceData *schema.ResourceData, catalog *instance_catalog.Catalog, listField string, names []string) error {
+	types := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		t := catalog.Lookup(name)
+		if t == nil {
+			continue
+		}
+		spot := "unknown"
+		if t.Spot != nil {
+			spot = strconv.FormatBool(*t.Spot)
+		}
+		types = append(types, map[string]interface{}{
+			catalogName:         t.Name,
+			catalogFamily:       t.Family,
+			catalogCategory:     t.Category,
+			catalogArchitecture: t.Architecture,
+			catalogVCPU:         t.VCPU,
+			catalogMemoryGiB:    t.MemoryGiB,
+			catalogGPU:          t.GPU,
+			catalogGPUType:      t.GPUType,
+			catalogSpot:         spot,
+		})
+	}
+
+	if err := resourceData.Set(catalogNames, names); err != nil {
+		return fmt.Errorf("failed to set %s: %v", catalogNames, err)
+	}
+	if err := resourceData.Set(catalogUpdatedAt, catalog.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to set %s: %v", catalogUpdatedAt, err)
+	}
+	if err := resourceData.Set(catalogComplete, catalog.Complete); err != nil {
+		return fmt.Errorf("failed to set %s: %v", catalogComplete, err)
+	}
+	if err := resourceData.Set(listField, types); err != nil {
+		return fmt.Errorf("failed to set %s: %v", listField, err)
+	}
+
+	id := fmt.Sprintf("%s-%s-%s", catalog.Cloud, catalog.UpdatedAt, strings.Join(names, ","))
+	resourceData.SetId(strconv.Itoa(schema.HashString(id)))
+	return nil
+}
@@ -0,0 +1,63 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aks_np_vm_sizes"
+)
+
+// The AKS VM sizes are served from the static instance type catalog and the data
+// source makes no API calls. The catalog does not record which types each
+// region or zone offers, so there is no region or zone input.
+func dataSourceSpotinstOceanAKSVmSizes() *schema.Resource {
+	s := catalogDataSourceSchema("vm_sizes")
+	s[string(ocean_aks_np_vm_sizes.Filters)] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     ocean_aks_np_vm_sizes.FiltersResource(),
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanAKSVmSizesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOceanAKSVmSizesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OceanAKSVmSizesDataSourceName)
+
+	catalog, err := instance_catalog.Load(instance_catalog.Azure)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters := &azure_np.Filters{}
+	if v, ok := resourceData.GetOk(string(ocean_aks_np_vm_sizes.Filters)); ok {
+		if filters, err = ocean_aks_np_vm_sizes.ExpandFilters(v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	names, err := instance_catalog.ResolveAzureFilters(catalog, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("%s: %v", ocean_aks_np_vm_sizes.Filters, err))
+	}
+
+	if err := setCatalogDataSource(resourceData, catalog, "vm_sizes", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(names) == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  catalog.NoMatchWarning(string(ocean_aks_np_vm_sizes.Filters)),
+		}}
+	}
+	return nil
+}
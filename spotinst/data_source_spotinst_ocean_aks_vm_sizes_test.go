@@ -0,0 +1,56 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAKSVmSizesDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OceanAKSVmSizesDataSourceName), name)
+}
+
+// region OceanAKSVmSizes: Baseline
+func TestAccSpotinstOceanAKSVmSizesDataSource_Baseline(t *testing.T) {
+	name := "test-acc-ocean-aks-vm-sizes"
+	dataSourceName := createOceanAKSVmSizesDataSourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "azure") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testBaselineOceanAKSVmSizesDataSourceConfig, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", "Standard_D2s_v5"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.1", "Standard_D4s_v5"),
+					resource.TestCheckResourceAttr(dataSourceName, "vm_sizes.0.family", "Dsv5"),
+					resource.TestCheckResourceAttr(dataSourceName, "vm_sizes.0.vcpu", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "catalog_updated_at"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanAKSVmSizesDataSourceConfig = `
+provider "azure" {
+ token   = "fake"
+ account = "fake"
+}
+
+data "` + string(commons.OceanAKSVmSizesDataSourceName) + `" "%v" {
+  provider = "azure"
+
+  filters {
+    series   = ["Dsv5"]
+    max_vcpu = 4
+  }
+}
+`
+
+// endregion
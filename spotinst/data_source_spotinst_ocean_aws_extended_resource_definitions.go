@@ -0,0 +1,116 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	erd "github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_extended_resource_definition"
+)
+
+func dataSourceSpotinstOceanAWSExtendedResourceDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanAWSExtendedResourceDefinitionsRead,
+		Schema: map[string]*schema.Schema{
+			string(erd.NameRegex): orgNameRegexSchema(),
+
+			string(erd.IDs): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(erd.Names): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(erd.ExtendedResourceDefinitions): {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(erd.ID): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(erd.ExtendedResourceName): {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						string(erd.Mapping): {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOceanAWSExtendedResourceDefinitionsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OceanAWSExtendedResourceDefinitionsDataSourceName)
+
+	erds, err := listExtendedResourceDefinitions(ctx, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	match := orgNameMatcher(resourceData, string(erd.NameRegex))
+	ids := make([]string, 0, len(erds))
+	names := make([]string, 0, len(erds))
+	result := make([]interface{}, 0, len(erds))
+	for _, definition := range erds {
+		if !match(spotinst.StringValue(definition.Name)) {
+			continue
+		}
+		ids = append(ids, spotinst.StringValue(definition.ID))
+		names = append(names, spotinst.StringValue(definition.Name))
+		result = append(result, flattenExtendedResourceDefinition(definition))
+	}
+
+	if err := resourceData.Set(string(erd.IDs), ids); err != nil {
+		return diag.Errorf("failed to set %s: %v", erd.IDs, err)
+	}
+	if err := resourceData.Set(string(erd.Names), names); err != nil {
+		return diag.Errorf("failed to set %s: %v", erd.Names, err)
+	}
+	if err := resourceData.Set(string(erd.ExtendedResourceDefinitions), result); err != nil {
+		return diag.Errorf("failed to set %s: %v", erd.ExtendedResourceDefinitions, err)
+	}
+
+	setOrgListID(resourceData, ids)
+	return nil
+}
+
+// listExtendedResourceDefinitions returns the extended resource definitions
+// of the account.
+func listExtendedResourceDefinitions(ctx context.Context, spotinstClient *Client) ([]*aws.ExtendedResourceDefinition, error) {
+	resp, err := spotinstClient.ocean.CloudProviderAWS().ListExtendedResourceDefinition(ctx, &aws.ListExtendedResourceDefinitionsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list extended resource definitions: %v", err)
+	}
+	return resp.ExtendedResourceDefinitions, nil
+}
+
+func flattenExtendedResourceDefinition(definition *aws.ExtendedResourceDefinition) map[string]interface{} {
+	mapping := make(map[string]interface{}, len(definition.Mapping))
+	for k, v := range definition.Mapping {
+		mapping[k] = fmt.Sprint(v)
+	}
+	return map[string]interface{}{
+		string(erd.ID):                   spotinst.StringValue(definition.ID),
+		string(erd.ExtendedResourceName): spotinst.StringValue(definition.Name),
+		string(erd.Mapping):              mapping,
+	}
+}
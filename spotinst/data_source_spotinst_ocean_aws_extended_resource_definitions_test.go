@@ -0,0 +1,63 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSExtendedResourceDefinitionsDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OceanAWSExtendedResourceDefinitionsDataSourceName), name)
+}
+
+// region OceanAWSExtendedResourceDefinitions: Baseline
+func TestAccSpotinstOceanAWSExtendedResourceDefinitionsDataSource_Baseline(t *testing.T) {
+	name := "test-acc-ocean-aws-erds-ds"
+	resourceName := createOceanAWSExtendedResourceDefinitionResourceName(name)
+	dataSourceName := createOceanAWSExtendedResourceDefinitionsDataSourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanAWSExtendedResourceDefinitionDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testBaselineOceanAWSExtendedResourceDefinitionsDataSourceConfig, name, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", "example.com/terraform-test-erds-ds"),
+					resource.TestCheckResourceAttr(dataSourceName, "extended_resource_definitions.0.resource_mapping.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "extended_resource_definitions.0.resource_mapping.c3.large", "2Ki"),
+					resource.TestCheckResourceAttr(dataSourceName, "extended_resource_definitions.0.resource_mapping.*", "1Ki"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanAWSExtendedResourceDefinitionsDataSourceConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+resource "` + string(commons.OceanAWSExtendedResourceDefinitionResourceName) + `" "%v" {
+  provider = "aws"
+  name     = "example.com/terraform-test-erds-ds"
+  resource_mapping = {
+    "c3.large" = "2Ki"
+    "*"        = "1Ki"
+  }
+}
+
+data "` + string(commons.OceanAWSExtendedResourceDefinitionsDataSourceName) + `" "%v" {
+  provider   = "aws"
+  name_regex = "^${` + string(commons.OceanAWSExtendedResourceDefinitionResourceName) + `.%v.name}$"
+}
+`
+
+// endregion
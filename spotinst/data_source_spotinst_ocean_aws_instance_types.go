@@ -0,0 +1,63 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_instance_types"
+)
+
+// The AWS instance types are served from the static instance type catalog and the data
+// source makes no API calls. The catalog does not record which types each
+// region or zone offers, so there is no region or zone input.
+func dataSourceSpotinstOceanAWSInstanceTypes() *schema.Resource {
+	s := catalogDataSourceSchema("instance_types")
+	s[string(ocean_aws_instance_types.Filters)] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     ocean_aws_instance_types.FiltersResource(),
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanAWSInstanceTypesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOceanAWSInstanceTypesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OceanAWSInstanceTypesDataSourceName)
+
+	catalog, err := instance_catalog.Load(instance_catalog.AWS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters := &aws.Filters{}
+	if v, ok := resourceData.GetOk(string(ocean_aws_instance_types.Filters)); ok {
+		if filters, err = ocean_aws_instance_types.ExpandFilters(v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	names, err := instance_catalog.ResolveAWSFilters(catalog, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("%s: %v", ocean_aws_instance_types.Filters, err))
+	}
+
+	if err := setCatalogDataSource(resourceData, catalog, "instance_types", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(names) == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  catalog.NoMatchWarning(string(ocean_aws_instance_types.Filters)),
+		}}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOceanAWSInstanceTypesDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OceanAWSInstanceTypesDataSourceName), name)
+}
+
+// region OceanAWSInstanceTypes: Baseline
+func TestAccSpotinstOceanAWSInstanceTypesDataSource_Baseline(t *testing.T) {
+	name := "test-acc-ocean-aws-instance-types"
+	dataSourceName := createOceanAWSInstanceTypesDataSourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testBaselineOceanAWSInstanceTypesDataSourceConfig, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.0", "m7g.2xlarge"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.1", "m7g.xlarge"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0.architecture", "arm64"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0.vcpu", "8"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0.memory_gib", "32"),
+					resource.TestCheckResourceAttr(dataSourceName, "instance_types.0.spot_available", "unknown"),
+					resource.TestCheckResourceAttr(dataSourceName, "catalog_complete", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "catalog_updated_at"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOceanAWSInstanceTypesDataSourceConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+data "` + string(commons.OceanAWSInstanceTypesDataSourceName) + `" "%v" {
+  provider = "aws"
+
+  filters {
+    include_families = ["m7g"]
+    min_vcpu         = 4
+    max_vcpu         = 8
+  }
+}
+`
+
+// endregion
@@ -0,0 +1,187 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_instance_types"
+)
+
+// The GKE machine type filters reuse the names of the Ocean AWS instance
+// type filters that apply to GCP machine types.
+const (
+	gkeFilters         = ocean_aws_instance_types.Filters
+	gkeArchitectures   = ocean_aws_instance_types.Architectures
+	gkeCategories      = ocean_aws_instance_types.Categories
+	gkeIncludeFamilies = ocean_aws_instance_types.IncludeFamilies
+	gkeExcludeFamilies = ocean_aws_instance_types.ExcludeFamilies
+	gkeMinVcpu         = ocean_aws_instance_types.MinVcpu
+	gkeMaxVcpu         = ocean_aws_instance_types.MaxVcpu
+	gkeMinMemoryGiB    = ocean_aws_instance_types.MinMemoryGiB
+	gkeMaxMemoryGiB    = ocean_aws_instance_types.MaxMemoryGiB
+	gkeMinGpu          = ocean_aws_instance_types.MinGpu
+	gkeMaxGpu          = ocean_aws_instance_types.MaxGpu
+)
+
+// The GKE machine types are served from the static instance type catalog and the data
+// source makes no API calls. The catalog does not record which types each
+// region or zone offers, so there is no region or zone input.
+func dataSourceSpotinstOceanGKEMachineTypes() *schema.Resource {
+	s := catalogDataSourceSchema("machine_types")
+	s[string(gkeFilters)] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(gkeArchitectures): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				string(gkeCategories): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				string(gkeIncludeFamilies): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				string(gkeExcludeFamilies): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				string(gkeMinVcpu): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  -1,
+				},
+
+				string(gkeMaxVcpu): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  -1,
+				},
+
+				string(gkeMinMemoryGiB): {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  -1,
+				},
+
+				string(gkeMaxMemoryGiB): {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  -1,
+				},
+
+				string(gkeMinGpu): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  -1,
+				},
+
+				string(gkeMaxGpu): {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  -1,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOceanGKEMachineTypesRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOceanGKEMachineTypesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OceanGKEMachineTypesDataSourceName)
+
+	catalog, err := instance_catalog.Load(instance_catalog.GCP)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters := &instance_catalog.MachineTypeFilters{}
+	if v, ok := resourceData.GetOk(string(gkeFilters)); ok {
+		filters = expandGKEMachineTypeFilters(v)
+	}
+	names, err := instance_catalog.ResolveGCPFilters(catalog, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("%s: %v", gkeFilters, err))
+	}
+
+	if err := setCatalogDataSource(resourceData, catalog, "machine_types", names); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(names) == 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  catalog.NoMatchWarning(string(gkeFilters)),
+		}}
+	}
+	return nil
+}
+
+func expandGKEMachineTypeFilters(data interface{}) *instance_catalog.MachineTypeFilters {
+	filters := &instance_catalog.MachineTypeFilters{}
+	list := data.([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return filters
+	}
+	m := list[0].(map[string]interface{})
+
+	filters.Architectures = expandGKEMachineTypeFiltersList(m[string(gkeArchitectures)])
+	filters.Categories = expandGKEMachineTypeFiltersList(m[string(gkeCategories)])
+	filters.IncludeFamilies = expandGKEMachineTypeFiltersList(m[string(gkeIncludeFamilies)])
+	filters.ExcludeFamilies = expandGKEMachineTypeFiltersList(m[string(gkeExcludeFamilies)])
+
+	if v, ok := m[string(gkeMinVcpu)].(int); ok && v != -1 {
+		filters.MinVcpu = spotinst.Int(v)
+	}
+	if v, ok := m[string(gkeMaxVcpu)].(int); ok && v != -1 {
+		filters.MaxVcpu = spotinst.Int(v)
+	}
+	if v, ok := m[string(gkeMinMemoryGiB)].(float64); ok && v != -1 {
+		filters.MinMemoryGiB = spotinst.Float64(v)
+	}
+	if v, ok := m[string(gkeMaxMemoryGiB)].(float64); ok && v != -1 {
+		filters.MaxMemoryGiB = spotinst.Float64(v)
+	}
+	if v, ok := m[string(gkeMinGpu)].(int); ok && v != -1 {
+		filters.MinGpu = spotinst.Int(v)
+	}
+	if v, ok := m[string(gkeMaxGpu)].(int); ok && v != -1 {
+		filters.MaxGpu = spotinst.Int(v)
+	}
+	return filters
+}
+
+func expandGKEMachineTypeFiltersList(data interface{}) []string {
+	set, ok := data.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		if s, ok := v.(string); ok && s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package spotinst
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Attributes shared by the organization data sources.
+const (
+	orgIDs          = "ids"
+	orgNames        = "names"
+	orgNameRegex    = "name_regex"
+	orgPolicies     = "policies"
+	orgPolicyID     = "policy_id"
+	orgPolicyName   = "policy_name"
+	orgAccountIDs   = "account_ids"
+	orgUserID       = "user_id"
+	orgUserGroupID  = "user_group_id"
+	orgUserIDs      = "user_ids"
+	orgName         = "name"
+	orgDescription  = "description"
+	orgEmail        = "email"
+	orgUserGroupIDs = "user_group_ids"
+)
+
+func orgNameRegexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+}
+
+// orgNameMatcher returns a function reporting whether a name matches the
+// name_regex of a data source, or matches anything when it is unset.
+func orgNameMatcher(resourceData *schema.ResourceData, field string) func(string) bool {
+	v, ok := resourceData.GetOk(field)
+	if !ok {
+		return func(string) bool { return true }
+	}
+	re := regexp.MustCompile(v.(string))
+	return re.MatchString
+}
+
+// orgPoliciesSchema returns the computed policies of a user or user group.
+func orgPoliciesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				orgPolicyID: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				orgPolicyName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				orgAccountIDs: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// setOrgListID sets the ID of a list data source, which identifies the
+// objects it found.
+func setOrgListID(resourceData *schema.ResourceData, ids []string) {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	resourceData.SetId(strconv.Itoa(schema.HashString(strings.Join(sorted, ","))))
+}
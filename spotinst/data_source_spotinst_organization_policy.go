@@ -0,0 +1,189 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/organization_policy"
+)
+
+func dataSourceSpotinstOrganizationPolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationPolicyRead,
+		Schema: map[string]*schema.Schema{
+			orgName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			orgPolicyID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			orgDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			policyDocumentStatements: policyStatementsSchema(),
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			orgNameRegex: orgNameRegexSchema(),
+
+			orgIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgPolicies: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						orgPolicyID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						orgName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						orgDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationPolicyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationPolicyDataSourceName)
+
+	name := resourceData.Get(orgName).(string)
+	policies, err := listOrgPolicies(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found []*organization.Policy
+	for _, policy := range policies {
+		if spotinst.StringValue(policy.Name) == name {
+			found = append(found, policy)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return diag.Errorf("no policy named %q in the organization", name)
+	case 1:
+	default:
+		return diag.Errorf("%d policies named %q in the organization", len(found), name)
+	}
+
+	// Policies are listed with their content, so there is nothing more to
+	// read.
+	policy := found[0]
+	var statements []interface{}
+	if policy.PolicyContent != nil {
+		for _, statement := range policy.PolicyContent.Statements {
+			statements = append(statements, map[string]interface{}{
+				string(organization_policy.Effect):    spotinst.StringValue(statement.Effect),
+				string(organization_policy.Actions):   statement.Actions,
+				string(organization_policy.Resources): statement.Resources,
+			})
+		}
+	}
+
+	id := spotinst.StringValue(policy.PolicyID)
+	values := map[string]interface{}{
+		orgPolicyID:              id,
+		orgDescription:           spotinst.StringValue(policy.Description),
+		policyDocumentStatements: statements,
+	}
+	for k, v := range values {
+		if err := resourceData.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s: %v", k, err)
+		}
+	}
+
+	resourceData.SetId(id)
+	return nil
+}
+
+func dataSourceSpotinstOrganizationPoliciesRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationPoliciesDataSourceName)
+
+	policies, err := listOrgPolicies(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	match := orgNameMatcher(resourceData, orgNameRegex)
+	ids := make([]string, 0, len(policies))
+	names := make([]string, 0, len(policies))
+	result := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		if !match(spotinst.StringValue(policy.Name)) {
+			continue
+		}
+		ids = append(ids, spotinst.StringValue(policy.PolicyID))
+		names = append(names, spotinst.StringValue(policy.Name))
+		result = append(result, map[string]interface{}{
+			orgPolicyID:    spotinst.StringValue(policy.PolicyID),
+			orgName:        spotinst.StringValue(policy.Name),
+			orgDescription: spotinst.StringValue(policy.Description),
+		})
+	}
+
+	if err := resourceData.Set(orgIDs, ids); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgIDs, err)
+	}
+	if err := resourceData.Set(orgNames, names); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgNames, err)
+	}
+	if err := resourceData.Set(orgPolicies, result); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgPolicies, err)
+	}
+
+	setOrgListID(resourceData, ids)
+	return nil
+}
+
+// listOrgPolicies returns the access policies of the organization, sorted by
+// name.
+func listOrgPolicies(ctx context.Context, meta interface{}) ([]*organization.Policy, error) {
+	resp, err := meta.(*Client).organization.ListPolicies(ctx, &organization.ListPoliciesInput{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to list policies: %s", err)
+	}
+	policies := resp.Policies
+	sort.SliceStable(policies, func(i, j int) bool {
+		return spotinst.StringValue(policies[i].Name) < spotinst.StringValue(policies[j].Name)
+	})
+	return policies, nil
+}
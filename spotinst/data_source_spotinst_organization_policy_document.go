@@ -0,0 +1,265 @@
+package spotinst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/organization_policy"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/policy_catalog"
+)
+
+const (
+	policyDocumentSourceJSON      = "source_json"
+	policyDocumentStatement       = "statement"
+	policyDocumentExpandWildcards = "expand_wildcards"
+	policyDocumentJSON            = "json"
+	policyDocumentStatements      = "statements"
+
+	policyEffectAllow = "ALLOW"
+	policyEffectDeny  = "DENY"
+)
+
+// policyDocument is the JSON form of policy content, as accepted by the
+// policy_content of spotinst_organization_policy in the API.
+type policyDocument struct {
+	Statements []*policyStatement `json:"statements"`
+}
+
+type policyStatement struct {
+	Effect    string   `json:"effect"`
+	Actions   []string `json:"actions"`
+	Resources []string `json:"resources"`
+}
+
+func dataSourceSpotinstOrganizationPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationPolicyDocumentRead,
+		Schema: map[string]*schema.Schema{
+			policyDocumentSourceJSON: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+
+			policyDocumentStatement: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						string(organization_policy.Effect): {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      policyEffectAllow,
+							ValidateFunc: validation.StringInSlice([]string{policyEffectAllow, policyEffectDeny}, false),
+						},
+
+						string(organization_policy.Actions): {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: policy_catalog.ValidateAction,
+							},
+						},
+
+						string(organization_policy.Resources): {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: policy_catalog.ValidateResource,
+							},
+						},
+					},
+				},
+			},
+
+			policyDocumentExpandWildcards: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			policyDocumentJSON: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			policyDocumentStatements: policyStatementsSchema(),
+		},
+	}
+}
+
+// policyStatementsSchema returns the computed statements of a policy, in the
+// shape of the statements of spotinst_organization_policy.
+func policyStatementsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(organization_policy.Effect): {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				string(organization_policy.Actions): {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				string(organization_policy.Resources): {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationPolicyDocumentRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationPolicyDocumentDataSourceName)
+
+	var diags diag.Diagnostics
+	doc := &policyDocument{}
+
+	// Statements of the source documents come first, in order, and are
+	// validated here since they bypass the schema validation.
+	for i, v := range resourceData.Get(policyDocumentSourceJSON).([]interface{}) {
+		path := cty.GetAttrPath(policyDocumentSourceJSON).IndexInt(i)
+		source := &policyDocument{}
+		if err := json.Unmarshal([]byte(v.(string)), source); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid policy document",
+				Detail:        err.Error(),
+				AttributePath: path,
+			})
+		}
+		for _, statement := range source.Statements {
+			if statement.Effect == "" {
+				statement.Effect = policyEffectAllow
+			}
+			if statement.Effect != policyEffectAllow && statement.Effect != policyEffectDeny {
+				diags = append(diags, diag.Diagnostic{
+					Severity:      diag.Error,
+					Summary:       "Invalid policy effect",
+					Detail:        fmt.Sprintf("expected effect to be one of [%s %s], got %s", policyEffectAllow, policyEffectDeny, statement.Effect),
+					AttributePath: path,
+				})
+			}
+			for _, action := range statement.Actions {
+				diags = append(diags, policy_catalog.ValidateAction(action, path)...)
+			}
+			for _, resource := range statement.Resources {
+				diags = append(diags, policy_catalog.ValidateResource(resource, path)...)
+			}
+		}
+		doc.Statements = append(doc.Statements, source.Statements...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, v := range resourceData.Get(policyDocumentStatement).([]interface{}) {
+		m := v.(map[string]interface{})
+		doc.Statements = append(doc.Statements, &policyStatement{
+			Effect:    m[string(organization_policy.Effect)].(string),
+			Actions:   expandPolicyStrings(m[string(organization_policy.Actions)]),
+			Resources: expandPolicyStrings(m[string(organization_policy.Resources)]),
+		})
+	}
+
+	if resourceData.Get(policyDocumentExpandWildcards).(bool) {
+		catalog, err := policy_catalog.Load()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		expandPolicyStatements(catalog, doc.Statements)
+	}
+
+	statements := make([]interface{}, 0, len(doc.Statements))
+	for _, statement := range doc.Statements {
+		// A statement without resources applies to all of them.
+		if len(statement.Resources) == 0 {
+			statement.Resources = []string{policy_catalog.Wildcard}
+		}
+		statements = append(statements, map[string]interface{}{
+			string(organization_policy.Effect):    statement.Effect,
+			string(organization_policy.Actions):   statement.Actions,
+			string(organization_policy.Resources): statement.Resources,
+		})
+	}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to render the policy document: %v", err))...)
+	}
+	if err := resourceData.Set(policyDocumentJSON, string(data)); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to set %s: %v", policyDocumentJSON, err))...)
+	}
+	if err := resourceData.Set(policyDocumentStatements, statements); err != nil {
+		return append(diags, diag.FromErr(fmt.Errorf("failed to set %s: %v", policyDocumentStatements, err))...)
+	}
+
+	resourceData.SetId(strconv.Itoa(schema.HashString(string(data))))
+	return diags
+}
+
+func expandPolicyStrings(data interface{}) []string {
+	list := data.([]interface{})
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
+// expandPolicyStatements expands the action patterns of ALLOW statements.
+// Expanding a DENY would stop denying actions added to Spot after the
+// catalog snapshot, so DENY statements are left as they are.
+func expandPolicyStatements(catalog *policy_catalog.Catalog, statements []*policyStatement) {
+	for _, statement := range statements {
+		if statement.Effect == policyEffectAllow {
+			statement.Actions = expandPolicyActions(catalog, statement.Actions)
+		}
+	}
+}
+
+// expandPolicyActions replaces action patterns such as "ocean:create*" with
+// the catalog actions they match, dropping duplicates. The "*" wildcard and
+// patterns that match no known action are kept as they are.
+func expandPolicyActions(catalog *policy_catalog.Catalog, actions []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(actions))
+	for _, pattern := range actions {
+		expanded := []string{pattern}
+		if pattern != policy_catalog.Wildcard && strings.Contains(pattern, policy_catalog.Wildcard) {
+			if matches := catalog.Expand(pattern); len(matches) > 0 {
+				expanded = matches
+			}
+		}
+		for _, action := range expanded {
+			if !seen[action] {
+				seen[action] = true
+				result = append(result, action)
+			}
+		}
+	}
+	return result
+}
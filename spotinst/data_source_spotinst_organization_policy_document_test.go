@@ -0,0 +1,95 @@
+package spotinst
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/policy_catalog"
+)
+
+func createOrganizationPolicyDocumentDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OrganizationPolicyDocumentDataSourceName), name)
+}
+
+// region OrganizationPolicyDocument: Baseline
+func TestAccSpotinstOrganizationPolicyDocumentDataSource_Baseline(t *testing.T) {
+	name := "test-acc-organization-policy-document"
+	dataSourceName := createOrganizationPolicyDocumentDataSourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t, "aws") },
+		Providers: TestAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testBaselineOrganizationPolicyDocumentDataSourceConfig, name, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "statements.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.effect", "DENY"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.actions.0", "ocean:deleteCluster"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.actions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.actions.1", "ocean:delete*"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.effect", "ALLOW"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.actions.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.actions.0", "ocean:listClusters"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.1.resources.0", "*"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "json"),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOrganizationPolicyDocumentDataSourceConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+data "` + string(commons.OrganizationPolicyDocumentDataSourceName) + `" "%v-source" {
+  provider = "aws"
+
+  statement {
+    effect    = "DENY"
+    actions   = ["ocean:deleteCluster", "ocean:delete*"]
+    resources = ["o-abcd1234"]
+  }
+}
+
+data "` + string(commons.OrganizationPolicyDocumentDataSourceName) + `" "%v" {
+  provider = "aws"
+
+  source_json      = [data.` + string(commons.OrganizationPolicyDocumentDataSourceName) + `.%v-source.json]
+  expand_wildcards = true
+
+  statement {
+    actions = ["ocean:list*", "ocean:listClusters"]
+  }
+}
+`
+
+// endregion
+
+func TestExpandPolicyStatements(t *testing.T) {
+	catalog, err := policy_catalog.Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statements := []*policyStatement{
+		{Effect: policyEffectAllow, Actions: []string{"ocean:delete*", "ocean:deleteCluster", "*"}},
+		{Effect: policyEffectDeny, Actions: []string{"ocean:delete*"}},
+	}
+	expandPolicyStatements(catalog, statements)
+
+	allow := strings.Join(statements[0].Actions, ",")
+	if allow != strings.Join(append(catalog.Expand("ocean:delete*"), "*"), ",") {
+		t.Errorf("unexpected ALLOW actions %s", allow)
+	}
+	if deny := strings.Join(statements[1].Actions, ","); deny != "ocean:delete*" {
+		t.Errorf("expected the DENY pattern to be kept, got %s", deny)
+	}
+}
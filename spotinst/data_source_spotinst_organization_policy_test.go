@@ -0,0 +1,79 @@
+package spotinst
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOrganizationPolicyDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OrganizationPolicyDataSourceName), name)
+}
+
+func createOrganizationPoliciesDataSourceName(name string) string {
+	return fmt.Sprintf("data.%v.%v", string(commons.OrganizationPoliciesDataSourceName), name)
+}
+
+// region OrganizationPolicy: Baseline
+func TestAccSpotinstOrganizationPolicyDataSource_Baseline(t *testing.T) {
+	name := "test-acc-organization-policy-ds"
+	resourceName := createOrganizationPolicyResourceName(name)
+	dataSourceName := createOrganizationPolicyDataSourceName(name)
+	listDataSourceName := createOrganizationPoliciesDataSourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOrganizationPolicyDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testBaselineOrganizationPolicyDataSourceConfig, name, name, name, name, name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "test-acc-organization-policy-ds"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.effect", "ALLOW"),
+					resource.TestCheckResourceAttr(dataSourceName, "statements.0.actions.0", "ocean:listClusters"),
+					resource.TestCheckResourceAttr(listDataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(listDataSourceName, "ids.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(listDataSourceName, "policies.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+const testBaselineOrganizationPolicyDataSourceConfig = `
+provider "aws" {
+ token   = "fake"
+ account = "fake"
+}
+
+resource "` + string(commons.OrgPolicyResourceName) + `" "%v" {
+  provider    = "aws"
+  name        = "%v"
+  description = "test-acc-organization-policy-ds"
+  policy_content {
+    statements {
+      actions   = ["ocean:listClusters"]
+      effect    = "ALLOW"
+      resources = ["*"]
+    }
+  }
+}
+
+data "` + string(commons.OrganizationPolicyDataSourceName) + `" "%v" {
+  provider = "aws"
+  name     = ` + string(commons.OrgPolicyResourceName) + `.%v.name
+}
+
+data "` + string(commons.OrganizationPoliciesDataSourceName) + `" "%v" {
+  provider   = "aws"
+  name_regex = "^${` + string(commons.OrgPolicyResourceName) + `.%v.name}$"
+}
+`
+
+// endregion
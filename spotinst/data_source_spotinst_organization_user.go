@@ -0,0 +1,261 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+const (
+	orgUsername   = "username"
+	orgFirstName  = "first_name"
+	orgLastName   = "last_name"
+	orgRole       = "role"
+	orgUserType   = "type"
+	orgMFA        = "mfa"
+	orgEmailRegex = "email_regex"
+	orgEmails     = "emails"
+	orgUsers      = "users"
+)
+
+// orgUserAttributes returns the computed attributes describing a user.
+func orgUserAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		orgUserID: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		orgUsername: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		orgFirstName: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		orgLastName: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		orgRole: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
+		orgUserType: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationUser() *schema.Resource {
+	s := orgUserAttributes()
+	s[orgEmail] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	s[orgMFA] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	s[orgUserGroupIDs] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s[orgPolicies] = orgPoliciesSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationUserRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceSpotinstOrganizationUsers() *schema.Resource {
+	users := orgUserAttributes()
+	users[orgEmail] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationUsersRead,
+		Schema: map[string]*schema.Schema{
+			orgEmailRegex: orgNameRegexSchema(),
+
+			orgIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgEmails: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgUsers: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Resource{Schema: users},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationUserRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationUserDataSourceName)
+
+	email := resourceData.Get(orgEmail).(string)
+	users, err := listOrgUsers(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found []*organization.User
+	for _, user := range users {
+		if strings.EqualFold(spotinst.StringValue(user.Email), email) {
+			found = append(found, user)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return diag.Errorf("no user with email %q in the organization", email)
+	case 1:
+	default:
+		return diag.Errorf("%d users with email %q in the organization", len(found), email)
+	}
+
+	// The list only has a summary of each user; policies and groups come
+	// with the user itself.
+	user := found[0]
+	if details, err := readOrgUserIfExists(ctx, spotinst.StringValue(user.UserID), meta); err != nil {
+		return diag.Errorf("[ERROR] Failed to read user: %s", err)
+	} else if details != nil {
+		if details.UserID == nil {
+			details.UserID = user.UserID
+		}
+		if details.Email == nil {
+			details.Email = user.Email
+		}
+		user = details
+	}
+
+	for k, v := range flattenOrgUser(user) {
+		if err := resourceData.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s: %v", k, err)
+		}
+	}
+	if err := resourceData.Set(orgMFA, spotinst.BoolValue(user.Mfa)); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgMFA, err)
+	}
+	if err := resourceData.Set(orgUserGroupIDs, orgUserGroupIDsOf(user)); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgUserGroupIDs, err)
+	}
+	if err := resourceData.Set(orgPolicies, flattenOrgUserPolicies(user.Policies)); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgPolicies, err)
+	}
+
+	resourceData.SetId(spotinst.StringValue(user.UserID))
+	return nil
+}
+
+func dataSourceSpotinstOrganizationUsersRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationUsersDataSourceName)
+
+	users, err := listOrgUsers(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	match := orgNameMatcher(resourceData, orgEmailRegex)
+	ids := make([]string, 0, len(users))
+	emails := make([]string, 0, len(users))
+	result := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		if !match(spotinst.StringValue(user.Email)) {
+			continue
+		}
+		ids = append(ids, spotinst.StringValue(user.UserID))
+		emails = append(emails, spotinst.StringValue(user.Email))
+		result = append(result, flattenOrgUser(user))
+	}
+
+	if err := resourceData.Set(orgIDs, ids); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgIDs, err)
+	}
+	if err := resourceData.Set(orgEmails, emails); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgEmails, err)
+	}
+	if err := resourceData.Set(orgUsers, result); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgUsers, err)
+	}
+
+	setOrgListID(resourceData, ids)
+	return nil
+}
+
+// listOrgUsers returns the users and programmatic users of the
+// organization, sorted by email.
+func listOrgUsers(ctx context.Context, meta interface{}) ([]*organization.User, error) {
+	resp, err := meta.(*Client).organization.ListUsers(ctx, &organization.ListUsersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to list users: %s", err)
+	}
+	users := resp.Users
+	sort.SliceStable(users, func(i, j int) bool {
+		return strings.ToLower(spotinst.StringValue(users[i].Email)) < strings.ToLower(spotinst.StringValue(users[j].Email))
+	})
+	return users, nil
+}
+
+func flattenOrgUser(user *organization.User) map[string]interface{} {
+	return map[string]interface{}{
+		orgUserID:    spotinst.StringValue(user.UserID),
+		orgEmail:     spotinst.StringValue(user.Email),
+		orgUsername:  spotinst.StringValue(user.Username),
+		orgFirstName: spotinst.StringValue(user.FirstName),
+		orgLastName:  spotinst.StringValue(user.LastName),
+		orgRole:      spotinst.StringValue(user.Role),
+		orgUserType:  spotinst.StringValue(user.Type),
+	}
+}
+
+// orgUserGroupIDsOf returns the groups of a user, which the API lists either
+// as IDs or as group summaries.
+func orgUserGroupIDsOf(user *organization.User) []string {
+	ids := append([]string(nil), user.UserGroupIds...)
+	for _, group := range user.Groups {
+		if group.Id != nil && !slices.Contains(ids, spotinst.StringValue(group.Id)) {
+			ids = append(ids, spotinst.StringValue(group.Id))
+		}
+	}
+	return ids
+}
+
+func flattenOrgUserPolicies(policies []*organization.UserPolicy) []interface{} {
+	result := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		result = append(result, map[string]interface{}{
+			orgPolicyID:   spotinst.StringValue(policy.PolicyId),
+			orgPolicyName: spotinst.StringValue(policy.PolicyName),
+			orgAccountIDs: policy.AccountIds,
+		})
+	}
+	return result
+}
@@ -0,0 +1,226 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+const (
+	orgCreatedAt   = "created_at"
+	orgUsersCount  = "users_count"
+	orgPolicyNames = "policy_names"
+	orgUserGroups  = "user_groups"
+)
+
+func dataSourceSpotinstOrganizationUserGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationUserGroupRead,
+		Schema: map[string]*schema.Schema{
+			orgName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			orgUserGroupID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			orgDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			orgCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			orgUserIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgPolicies: orgPoliciesSchema(),
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationUserGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSpotinstOrganizationUserGroupsRead,
+		Schema: map[string]*schema.Schema{
+			orgNameRegex: orgNameRegexSchema(),
+
+			orgIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			orgUserGroups: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						orgUserGroupID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						orgName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						orgDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						orgUsersCount: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						orgPolicyNames: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSpotinstOrganizationUserGroupRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationUserGroupDataSourceName)
+
+	name := resourceData.Get(orgName).(string)
+	userGroups, err := listOrgUserGroups(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found []*organization.UserGroup
+	for _, userGroup := range userGroups {
+		if spotinst.StringValue(userGroup.Name) == name {
+			found = append(found, userGroup)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return diag.Errorf("no user group named %q in the organization", name)
+	case 1:
+	default:
+		return diag.Errorf("%d user groups named %q in the organization", len(found), name)
+	}
+
+	// The list only has a summary of each group; users and policies come
+	// with the group itself.
+	id := spotinst.StringValue(found[0].UserGroupId)
+	userGroup, err := readOrgUserGroupIfExists(ctx, id, meta)
+	if err != nil {
+		return diag.Errorf("[ERROR] Failed to read User Group: %s", err)
+	}
+	if userGroup == nil {
+		return diag.Errorf("user group %s not found", id)
+	}
+
+	policies := make([]*organization.UserPolicy, 0, len(userGroup.Policies))
+	for _, policy := range userGroup.Policies {
+		policies = append(policies, &organization.UserPolicy{
+			PolicyId:   policy.PolicyId,
+			PolicyName: policy.PolicyName,
+			AccountIds: policy.AccountIds,
+		})
+	}
+
+	values := map[string]interface{}{
+		orgUserGroupID: id,
+		orgDescription: spotinst.StringValue(userGroup.Description),
+		orgCreatedAt:   spotinst.StringValue(userGroup.CreatedAt),
+		orgUserIDs:     orgUserGroupUserIDs(userGroup),
+		orgPolicies:    flattenOrgUserPolicies(policies),
+	}
+	for k, v := range values {
+		if err := resourceData.Set(k, v); err != nil {
+			return diag.Errorf("failed to set %s: %v", k, err)
+		}
+	}
+
+	resourceData.SetId(id)
+	return nil
+}
+
+func dataSourceSpotinstOrganizationUserGroupsRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.DataSourceOnRead), commons.OrganizationUserGroupsDataSourceName)
+
+	userGroups, err := listOrgUserGroups(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	match := orgNameMatcher(resourceData, orgNameRegex)
+	ids := make([]string, 0, len(userGroups))
+	names := make([]string, 0, len(userGroups))
+	result := make([]interface{}, 0, len(userGroups))
+	for _, userGroup := range userGroups {
+		if !match(spotinst.StringValue(userGroup.Name)) {
+			continue
+		}
+		ids = append(ids, spotinst.StringValue(userGroup.UserGroupId))
+		names = append(names, spotinst.StringValue(userGroup.Name))
+		result = append(result, map[string]interface{}{
+			orgUserGroupID: spotinst.StringValue(userGroup.UserGroupId),
+			orgName:        spotinst.StringValue(userGroup.Name),
+			orgDescription: spotinst.StringValue(userGroup.Description),
+			orgUsersCount:  spotinst.IntValue(userGroup.UsersCount),
+			orgPolicyNames: userGroup.PolicyNames,
+		})
+	}
+
+	if err := resourceData.Set(orgIDs, ids); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgIDs, err)
+	}
+	if err := resourceData.Set(orgNames, names); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgNames, err)
+	}
+	if err := resourceData.Set(orgUserGroups, result); err != nil {
+		return diag.Errorf("failed to set %s: %v", orgUserGroups, err)
+	}
+
+	setOrgListID(resourceData, ids)
+	return nil
+}
+
+// listOrgUserGroups returns the user groups of the organization, sorted by
+// name.
+func listOrgUserGroups(ctx context.Context, meta interface{}) ([]*organization.UserGroup, error) {
+	resp, err := meta.(*Client).organization.ListUserGroups(ctx, &organization.ListUserGroupsInput{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Failed to list user groups: %s", err)
+	}
+	userGroups := resp.UserGroups
+	sort.SliceStable(userGroups, func(i, j int) bool {
+		return spotinst.StringValue(userGroups[i].Name) < spotinst.StringValue(userGroups[j].Name)
+	})
+	return userGroups, nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -90,8 +91,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ElastigroupAWS,
 		Product,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(commons.AWSProducts, false),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
@@ -318,8 +320,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ElastigroupAWS,
 		HealthCheckType,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(commons.AWSHealthCheckTypes, false),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
@@ -438,8 +441,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ElastigroupAWS,
 		SubnetIDs,
 		&schema.Schema{
-			Type:          schema.TypeList,
-			Elem:          &schema.Schema{Type: schema.TypeString},
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commons.ValidateSubnetID,
+			},
 			ConflictsWith: []string{string(AvailabilityZones)},
 			Optional:      true,
 			Deprecated:    "This field will soon be deprecated and handled by availability_zones",
@@ -681,8 +687,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ElastigroupAWS,
 		TargetGroupArns,
 		&schema.Schema{
-			Type:     schema.TypeList,
-			Elem:     &schema.Schema{Type: schema.TypeString},
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commons.ValidateARN,
+			},
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -876,7 +885,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					string(TimeWindow): {
 						Type:     schema.TypeList,
 						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: commons.ValidateTimeWindow,
+						},
 					},
 				},
 			},
@@ -1038,8 +1050,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(HealthCheckType): {
-									Type:     schema.TypeString,
-									Optional: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(commons.AWSHealthCheckTypes, false),
 								},
 
 								string(WaitForRollPct): {
@@ -1117,7 +1130,29 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			},
 		},
 		nil, nil, nil, nil,
-	)
+	).AddStateUpgrader(0, func(rawState map[string]interface{}) error {
+		// Version 1 moves should_resume_stateful, which was set on the group
+		// before update_policy was introduced, into update_policy. A new
+		// update_policy doesn't roll the group.
+		value, ok := rawState[string(ShouldResumeStateful)]
+		if !ok {
+			return nil
+		}
+		delete(rawState, string(ShouldResumeStateful))
+		if value == nil {
+			return nil
+		}
+
+		policies := commons.StateBlocks(rawState, string(UpdatePolicy))
+		if len(policies) == 0 {
+			policies = append(policies, map[string]interface{}{string(ShouldRoll): false})
+			rawState[string(UpdatePolicy)] = []interface{}{policies[0]}
+		}
+		if policies[0][string(ShouldResumeStateful)] == nil {
+			policies[0][string(ShouldResumeStateful)] = value
+		}
+		return nil
+	})
 
 	fieldsMap[WaitForCapacity] = commons.NewGenericField(
 		commons.ElastigroupAWS,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -87,8 +88,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.ElastigroupAWSBeanstalk,
 		Product,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(commons.AWSProducts, false),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			beanstalkWrapper := resourceObject.(*commons.ElastigroupAWSBeanstalkWrapper)
@@ -376,8 +378,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(TimeWindow): {
-									Type:     schema.TypeString,
-									Optional: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: commons.ValidateTimeWindow,
 								},
 
 								string(UpdateLevel): {
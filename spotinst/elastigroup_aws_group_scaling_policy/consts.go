@@ -0,0 +1,12 @@
+package elastigroup_aws_group_scaling_policy
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	GroupID             commons.FieldName = "group_id"
+	ScalingUpPolicy     commons.FieldName = "scaling_up_policy"
+	ScalingDownPolicy   commons.FieldName = "scaling_down_policy"
+	ScalingTargetPolicy commons.FieldName = "scaling_target_policy"
+	MultipleMetrics     commons.FieldName = "multiple_metrics"
+	PolicyName          commons.FieldName = "policy_name"
+)
@@ -0,0 +1,158 @@
+package elastigroup_aws_group_scaling_policy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scaling_policies"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[GroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScalingPolicy,
+		GroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[ScalingUpPolicy] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScalingPolicy,
+		ScalingUpPolicy,
+		policySchema(elastigroup_aws_scaling_policies.UpDownScalingPolicySchema()),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			var policiesResult []interface{} = nil
+			if len(scaling.Up) > 0 {
+				policiesResult = elastigroup_aws_scaling_policies.FlattenAWSGroupScalingPolicy(scaling.Up, true)
+			}
+			if err := resourceData.Set(string(ScalingUpPolicy), policiesResult); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingUpPolicy), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok {
+				if policies, err := elastigroup_aws_scaling_policies.ExpandAWSGroupScalingPolicies(v, false, true); err != nil {
+					return err
+				} else {
+					scaling.SetUp(policies)
+				}
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ScalingDownPolicy] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScalingPolicy,
+		ScalingDownPolicy,
+		policySchema(elastigroup_aws_scaling_policies.UpDownScalingPolicySchema()),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			var policiesResult []interface{} = nil
+			if len(scaling.Down) > 0 {
+				policiesResult = elastigroup_aws_scaling_policies.FlattenAWSGroupScalingPolicy(scaling.Down, true)
+			}
+			if err := resourceData.Set(string(ScalingDownPolicy), policiesResult); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingDownPolicy), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok {
+				if policies, err := elastigroup_aws_scaling_policies.ExpandAWSGroupScalingPolicies(v, false, true); err != nil {
+					return err
+				} else {
+					scaling.SetDown(policies)
+				}
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[ScalingTargetPolicy] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScalingPolicy,
+		ScalingTargetPolicy,
+		policySchema(elastigroup_aws_scaling_policies.TargetScalingPolicySchema()),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			var policiesResult []interface{} = nil
+			if len(scaling.Target) > 0 {
+				policiesResult = elastigroup_aws_scaling_policies.FlattenAWSGroupScalingPolicy(scaling.Target, false)
+			}
+			if err := resourceData.Set(string(ScalingTargetPolicy), policiesResult); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingTargetPolicy), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			if v, ok := resourceData.GetOk(string(ScalingTargetPolicy)); ok {
+				if policies, err := elastigroup_aws_scaling_policies.ExpandAWSGroupScalingPolicies(v, false, false); err != nil {
+					return err
+				} else {
+					scaling.SetTarget(policies)
+				}
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[MultipleMetrics] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScalingPolicy,
+		MultipleMetrics,
+		elastigroup_aws_scaling_policies.MultipleMetricsSchema(),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			var result []interface{} = nil
+			if scaling.MultipleMetrics != nil {
+				result = elastigroup_aws_scaling_policies.FlattenMultipleMetrics(scaling.MultipleMetrics)
+			}
+			if err := resourceData.Set(string(MultipleMetrics), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultipleMetrics), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			scaling := resourceObject.(*commons.ElastigroupAWSScalingPolicyWrapper).GetScaling()
+			if v, ok := resourceData.GetOk(string(MultipleMetrics)); ok {
+				if multipleMetrics, err := elastigroup_aws_scaling_policies.ExpandMultipleMetrics(v); err != nil {
+					return err
+				} else {
+					scaling.SetMultipleMetrics(multipleMetrics)
+				}
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+}
+
+// policySchema turns the schema of the group's policy sets into a block
+// holding exactly one policy of the kind the resource manages.
+func policySchema(s *schema.Schema) *schema.Schema {
+	s.MaxItems = 1
+	s.ExactlyOneOf = []string{
+		string(ScalingUpPolicy),
+		string(ScalingDownPolicy),
+		string(ScalingTargetPolicy),
+	}
+	return s
+}
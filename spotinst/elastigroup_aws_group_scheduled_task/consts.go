@@ -0,0 +1,22 @@
+package elastigroup_aws_group_scheduled_task
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	GroupID              commons.FieldName = "group_id"
+	TaskType             commons.FieldName = "task_type"
+	CronExpression       commons.FieldName = "cron_expression"
+	Frequency            commons.FieldName = "frequency"
+	StartTime            commons.FieldName = "start_time"
+	IsEnabled            commons.FieldName = "is_enabled"
+	ScaleTargetCapacity  commons.FieldName = "scale_target_capacity"
+	ScaleMinCapacity     commons.FieldName = "scale_min_capacity"
+	ScaleMaxCapacity     commons.FieldName = "scale_max_capacity"
+	BatchSizePercentage  commons.FieldName = "batch_size_percentage"
+	GracePeriod          commons.FieldName = "grace_period"
+	TargetCapacity       commons.FieldName = "target_capacity"
+	MinCapacity          commons.FieldName = "min_capacity"
+	MaxCapacity          commons.FieldName = "max_capacity"
+	Adjustment           commons.FieldName = "adjustment"
+	AdjustmentPercentage commons.FieldName = "adjustment_percentage"
+)
@@ -0,0 +1,509 @@
+package elastigroup_aws_group_scheduled_task
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[GroupID] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		GroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[TaskType] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		TaskType,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(TaskType), spotinst.StringValue(task.Type)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskType), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(TaskType)); ok {
+				task.SetType(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[CronExpression] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		CronExpression,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commons.ValidateCronExpression,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(CronExpression), spotinst.StringValue(task.CronExpression)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CronExpression), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(CronExpression)); ok {
+				task.SetCronExpression(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[Frequency] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		Frequency,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(Frequency), spotinst.StringValue(task.Frequency)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Frequency), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(Frequency)); ok {
+				task.SetFrequency(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[StartTime] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		StartTime,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(StartTime), spotinst.StringValue(task.StartTime)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(StartTime), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(StartTime)); ok {
+				task.SetStartTime(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[IsEnabled] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		IsEnabled,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(IsEnabled), spotinst.BoolValue(task.IsEnabled)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(IsEnabled), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			task.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			task.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ScaleTargetCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		ScaleTargetCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.ScaleTargetCapacity != nil {
+				if err := resourceData.Set(string(ScaleTargetCapacity), spotinst.IntValue(task.ScaleTargetCapacity)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScaleTargetCapacity), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(ScaleTargetCapacity)); ok {
+				task.SetScaleTargetCapacity(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(ScaleTargetCapacity)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetScaleTargetCapacity(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ScaleMinCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		ScaleMinCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.ScaleMinCapacity != nil {
+				if err := resourceData.Set(string(ScaleMinCapacity), spotinst.IntValue(task.ScaleMinCapacity)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScaleMinCapacity), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(ScaleMinCapacity)); ok {
+				task.SetScaleMinCapacity(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(ScaleMinCapacity)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetScaleMinCapacity(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[ScaleMaxCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		ScaleMaxCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.ScaleMaxCapacity != nil {
+				if err := resourceData.Set(string(ScaleMaxCapacity), spotinst.IntValue(task.ScaleMaxCapacity)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScaleMaxCapacity), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(ScaleMaxCapacity)); ok {
+				task.SetScaleMaxCapacity(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(ScaleMaxCapacity)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetScaleMaxCapacity(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[BatchSizePercentage] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		BatchSizePercentage,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.BatchSizePercentage != nil {
+				if err := resourceData.Set(string(BatchSizePercentage), spotinst.IntValue(task.BatchSizePercentage)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(BatchSizePercentage), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(BatchSizePercentage)); ok {
+				task.SetBatchSizePercentage(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(BatchSizePercentage)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetBatchSizePercentage(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[GracePeriod] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		GracePeriod,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.GracePeriod != nil {
+				if err := resourceData.Set(string(GracePeriod), spotinst.IntValue(task.GracePeriod)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(GracePeriod), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(GracePeriod)); ok {
+				task.SetGracePeriod(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(GracePeriod)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetGracePeriod(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[TargetCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		TargetCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.TargetCapacity != nil {
+				if err := resourceData.Set(string(TargetCapacity), spotinst.IntValue(task.TargetCapacity)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TargetCapacity), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(TargetCapacity)); ok {
+				task.SetTargetCapacity(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(TargetCapacity)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetTargetCapacity(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MinCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		MinCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.MinCapacity != nil {
+				if err := resourceData.Set(string(MinCapacity), spotinst.IntValue(task.MinCapacity)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MinCapacity), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(MinCapacity)); ok {
+				task.SetMinCapacity(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(MinCapacity)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetMinCapacity(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[MaxCapacity] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		MaxCapacity,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.MaxCapacity != nil {
+				if err := resourceData.Set(string(MaxCapacity), spotinst.IntValue(task.MaxCapacity)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MaxCapacity), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(MaxCapacity)); ok {
+				task.SetMaxCapacity(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(MaxCapacity)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetMaxCapacity(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Adjustment] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		Adjustment,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.Adjustment != nil {
+				if err := resourceData.Set(string(Adjustment), spotinst.IntValue(task.Adjustment)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Adjustment), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(Adjustment)); ok {
+				task.SetAdjustment(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(Adjustment)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetAdjustment(value)
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[AdjustmentPercentage] = commons.NewGenericField(
+		commons.ElastigroupAWSGroupScheduledTask,
+		AdjustmentPercentage,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if task.AdjustmentPercentage != nil {
+				if err := resourceData.Set(string(AdjustmentPercentage), spotinst.IntValue(task.AdjustmentPercentage)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(AdjustmentPercentage), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOkExists(string(AdjustmentPercentage)); ok {
+				task.SetAdjustmentPercentage(spotinst.Int(v.(int)))
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.ElastigroupAWSScheduledTaskWrapper).GetTask()
+			var value *int = nil
+			if v, ok := resourceData.GetOkExists(string(AdjustmentPercentage)); ok {
+				value = spotinst.Int(v.(int))
+			}
+			task.SetAdjustmentPercentage(value)
+			return nil
+		},
+		nil,
+	)
+}
@@ -83,8 +83,9 @@ func SetupElasticBeanstalk(fieldsMap map[commons.FieldName]*commons.GenericField
 											},
 
 											string(TimeWindow): {
-												Type:     schema.TypeString,
-												Optional: true,
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: commons.ValidateTimeWindow,
 											},
 
 											string(UpdateLevel): {
@@ -11,6 +11,8 @@ import (
 
 func SetupKubernetes(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("kubernetes.token")
+
 	fieldsMap[IntegrationKubernetes] = commons.NewGenericField(
 		commons.ElastigroupAWSIntegrations,
 		IntegrationKubernetes,
@@ -36,8 +38,9 @@ func SetupKubernetes(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Token): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Optional:  true,
 					},
 
 					string(AutoscaleIsEnabled): {
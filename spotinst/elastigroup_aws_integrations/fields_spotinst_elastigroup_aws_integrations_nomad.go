@@ -12,6 +12,8 @@ import (
 
 func SetupNomad(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("nomad.aclToken")
+
 	fieldsMap[IntegrationNomad] = commons.NewGenericField(
 		commons.ElastigroupAWSIntegrations,
 		IntegrationNomad,
@@ -42,8 +44,9 @@ func SetupNomad(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(AclToken): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Optional:  true,
 					},
 
 					string(AutoscaleHeadroom): {
@@ -11,6 +11,8 @@ import (
 
 func SetupRancher(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("rancher.accessKey", "rancher.secretKey")
+
 	fieldsMap[IntegrationRancher] = commons.NewGenericField(
 		commons.ElastigroupAWSIntegrations,
 		IntegrationRancher,
@@ -26,13 +28,15 @@ func SetupRancher(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(AccessKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Required:  true,
 					},
 
 					string(SecretKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Required:  true,
 					},
 
 					string(Version): {
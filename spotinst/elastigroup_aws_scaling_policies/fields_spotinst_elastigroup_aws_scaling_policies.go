@@ -14,14 +14,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[ScalingUpPolicy] = commons.NewGenericField(
 		commons.ElastigroupAWSScalingPolicies,
 		ScalingUpPolicy,
-		upDownScalingPolicySchema(),
+		UpDownScalingPolicySchema(),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Up != nil {
 				scaleUpPolicies := elastigroup.Scaling.Up
-				policiesResult = flattenAWSGroupScalingPolicy(scaleUpPolicies, true)
+				policiesResult = FlattenAWSGroupScalingPolicy(scaleUpPolicies, true)
 			}
 			if err := resourceData.Set(string(ScalingUpPolicy), policiesResult); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingUpPolicy), err)
@@ -32,7 +32,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok {
-				if policies, err := expandAWSGroupScalingPolicies(v, false, true); err != nil {
+				if policies, err := ExpandAWSGroupScalingPolicies(v, false, true); err != nil {
 					return err
 				} else {
 					elastigroup.Scaling.SetUp(policies)
@@ -45,7 +45,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var value []*aws.ScalingPolicy = nil
 			if v, ok := resourceData.GetOk(string(ScalingUpPolicy)); ok && v != nil {
-				if policies, err := expandAWSGroupScalingPolicies(v, true, true); err != nil {
+				if policies, err := ExpandAWSGroupScalingPolicies(v, true, true); err != nil {
 					return err
 				} else {
 					value = policies
@@ -60,14 +60,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[ScalingDownPolicy] = commons.NewGenericField(
 		commons.ElastigroupAWSScalingPolicies,
 		ScalingDownPolicy,
-		upDownScalingPolicySchema(),
+		UpDownScalingPolicySchema(),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Down != nil {
 				scaleDownPolicies := elastigroup.Scaling.Down
-				policiesResult = flattenAWSGroupScalingPolicy(scaleDownPolicies, true)
+				policiesResult = FlattenAWSGroupScalingPolicy(scaleDownPolicies, true)
 			}
 			if err := resourceData.Set(string(ScalingDownPolicy), policiesResult); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingDownPolicy), err)
@@ -78,7 +78,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok {
-				if policies, err := expandAWSGroupScalingPolicies(v, false, true); err != nil {
+				if policies, err := ExpandAWSGroupScalingPolicies(v, false, true); err != nil {
 					return err
 				} else {
 					elastigroup.Scaling.SetDown(policies)
@@ -91,7 +91,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var value []*aws.ScalingPolicy = nil
 			if v, ok := resourceData.GetOk(string(ScalingDownPolicy)); ok && v != nil {
-				if policies, err := expandAWSGroupScalingPolicies(v, true, true); err != nil {
+				if policies, err := ExpandAWSGroupScalingPolicies(v, true, true); err != nil {
 					return err
 				} else {
 					value = policies
@@ -106,14 +106,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[ScalingTargetPolicy] = commons.NewGenericField(
 		commons.ElastigroupAWSScalingPolicies,
 		ScalingTargetPolicy,
-		targetScalingPolicySchema(),
+		TargetScalingPolicySchema(),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.Target != nil {
 				scaleTargetPolicies := elastigroup.Scaling.Target
-				policiesResult = flattenAWSGroupScalingPolicy(scaleTargetPolicies, false)
+				policiesResult = FlattenAWSGroupScalingPolicy(scaleTargetPolicies, false)
 			}
 			if err := resourceData.Set(string(ScalingTargetPolicy), policiesResult); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ScalingTargetPolicy), err)
@@ -124,7 +124,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(ScalingTargetPolicy)); ok {
-				if policies, err := expandAWSGroupScalingPolicies(v, false, false); err != nil {
+				if policies, err := ExpandAWSGroupScalingPolicies(v, false, false); err != nil {
 					return err
 				} else {
 					elastigroup.Scaling.SetTarget(policies)
@@ -137,7 +137,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var value []*aws.ScalingPolicy = nil
 			if v, ok := resourceData.GetOk(string(ScalingTargetPolicy)); ok && v != nil {
-				if policies, err := expandAWSGroupScalingPolicies(v, true, false); err != nil {
+				if policies, err := ExpandAWSGroupScalingPolicies(v, true, false); err != nil {
 					return err
 				} else {
 					value = policies
@@ -152,90 +152,14 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	fieldsMap[MultipleMetrics] = commons.NewGenericField(
 		commons.ElastigroupAWSScalingPolicies,
 		MultipleMetrics,
-		&schema.Schema{
-			Type:     schema.TypeSet,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					string(Expressions): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								string(Expression): {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-
-								string(Name): {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-							},
-						},
-					},
-
-					string(Metrics): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem: &schema.Resource{
-							Schema: map[string]*schema.Schema{
-								string(Dimensions): {
-									Type: schema.TypeList,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											string(DimensionName): {
-												Type:     schema.TypeString,
-												Required: true,
-											},
-
-											string(DimensionValue): {
-												Type:     schema.TypeString,
-												Optional: true,
-											},
-										},
-									},
-									Optional: true,
-								},
-
-								string(ExtendedStatistic): {
-									Type:     schema.TypeString,
-									Optional: true,
-								},
-								string(MetricName): {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-								string(Name): {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-								string(Namespace): {
-									Type:     schema.TypeString,
-									Required: true,
-								},
-								string(Statistic): {
-									Type:     schema.TypeString,
-									Optional: true,
-								},
-								string(Unit): {
-									Type:     schema.TypeString,
-									Optional: true,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+		MultipleMetricsSchema(),
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			var policiesResult []interface{} = nil
 			if elastigroup.Scaling != nil && elastigroup.Scaling.MultipleMetrics != nil {
 				multipleMetrics := elastigroup.Scaling.MultipleMetrics
-				policiesResult = flattenMultipleMetrics(multipleMetrics)
+				policiesResult = FlattenMultipleMetrics(multipleMetrics)
 			}
 			if err := resourceData.Set(string(MultipleMetrics), policiesResult); err != nil {
 				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(MultipleMetrics), err)
@@ -246,7 +170,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			egWrapper := resourceObject.(*commons.ElastigroupWrapper)
 			elastigroup := egWrapper.GetElastigroup()
 			if v, ok := resourceData.GetOk(string(MultipleMetrics)); ok {
-				if multipleMetrics, err := expandMultipleMetrics(v); err != nil {
+				if multipleMetrics, err := ExpandMultipleMetrics(v); err != nil {
 					return err
 				} else {
 					elastigroup.Scaling.SetMultipleMetrics(multipleMetrics)
@@ -259,7 +183,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			elastigroup := egWrapper.GetElastigroup()
 			var value *aws.MultipleMetrics = nil
 			if v, ok := resourceData.GetOk(string(MultipleMetrics)); ok && v != nil {
-				if multipleMetrics, err := expandMultipleMetrics(v); err != nil {
+				if multipleMetrics, err := ExpandMultipleMetrics(v); err != nil {
 					return err
 				} else {
 					value = multipleMetrics
@@ -338,7 +262,7 @@ func baseScalingPolicySchema() *schema.Schema {
 	}
 }
 
-func upDownScalingPolicySchema() *schema.Schema {
+func UpDownScalingPolicySchema() *schema.Schema {
 	o := baseScalingPolicySchema()
 	s := o.Elem.(*schema.Resource).Schema
 
@@ -473,7 +397,7 @@ func upDownScalingPolicySchema() *schema.Schema {
 	return o
 }
 
-func targetScalingPolicySchema() *schema.Schema {
+func TargetScalingPolicySchema() *schema.Schema {
 	o := baseScalingPolicySchema()
 	s := o.Elem.(*schema.Resource).Schema
 
@@ -505,7 +429,87 @@ func targetScalingPolicySchema() *schema.Schema {
 	return o
 }
 
-func expandMultipleMetrics(data interface{}) (*aws.MultipleMetrics, error) {
+func MultipleMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(Expressions): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							string(Expression): {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							string(Name): {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				string(Metrics): {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							string(Dimensions): {
+								Type: schema.TypeList,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										string(DimensionName): {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+
+										string(DimensionValue): {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+								Optional: true,
+							},
+
+							string(ExtendedStatistic): {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							string(MetricName): {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							string(Name): {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							string(Namespace): {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							string(Statistic): {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							string(Unit): {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ExpandMultipleMetrics(data interface{}) (*aws.MultipleMetrics, error) {
 	list := data.(*schema.Set).List()
 	multipleMetrics := &aws.MultipleMetrics{}
 
@@ -536,7 +540,7 @@ func expandMultipleMetrics(data interface{}) (*aws.MultipleMetrics, error) {
 	return nil, nil
 }
 
-func expandAWSGroupScalingPolicies(data interface{}, nullify bool, scalingUpDown bool) ([]*aws.ScalingPolicy, error) {
+func ExpandAWSGroupScalingPolicies(data interface{}, nullify bool, scalingUpDown bool) ([]*aws.ScalingPolicy, error) {
 	list := data.(*schema.Set).List()
 	policies := make([]*aws.ScalingPolicy, 0, len(list))
 	for _, item := range list {
@@ -841,7 +845,7 @@ func expandExpressions(data interface{}) []*aws.Expressions {
 	return expressions
 }
 
-func flattenAWSGroupScalingPolicy(policies []*aws.ScalingPolicy, scalingUpDown bool) []interface{} {
+func FlattenAWSGroupScalingPolicy(policies []*aws.ScalingPolicy, scalingUpDown bool) []interface{} {
 	result := make([]interface{}, 0, len(policies))
 	for _, policy := range policies {
 		m := make(map[string]interface{})
@@ -926,7 +930,7 @@ func flattenAWSGroupScalingPolicy(policies []*aws.ScalingPolicy, scalingUpDown b
 	return result
 }
 
-func flattenMultipleMetrics(multipleMetrics *aws.MultipleMetrics) []interface{} {
+func FlattenMultipleMetrics(multipleMetrics *aws.MultipleMetrics) []interface{} {
 	result := make(map[string]interface{})
 
 	result[string(Expressions)] = flattenExpressions(multipleMetrics.Expressions)
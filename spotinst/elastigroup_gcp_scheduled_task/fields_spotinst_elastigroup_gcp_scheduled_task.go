@@ -32,8 +32,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 
 					string(TargetCapacity): {
@@ -0,0 +1,530 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	elastigroupAWS "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	managedInstanceAWS "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// GenerateOptions configures Generate.
+type GenerateOptions struct {
+	// Token and Account override the credentials otherwise read from the
+	// environment or the credentials file.
+	Token   string
+	Account string
+
+	// ResourceTypes limits generation to the given resource types. All
+	// supported resource types are generated when empty.
+	ResourceTypes []string
+}
+
+type generateLister func(ctx context.Context, client *Client) ([]commons.NamedResource, error)
+
+// generateResourceTypes lists the resource types supported by Generate.
+// Parents come before their children so that children can reference them.
+var generateResourceTypes = []struct {
+	resourceName commons.ResourceName
+	list         generateLister
+}{
+	{commons.ElastigroupAWSResourceName, listElastigroupAWSForGenerate},
+	{commons.OceanAWSResourceName, listOceanAWSForGenerate},
+	{commons.OceanAWSLaunchSpecResourceName, listOceanAWSLaunchSpecsForGenerate},
+	{commons.OceanECSResourceName, listOceanECSForGenerate},
+	{commons.OceanECSLaunchSpecResourceName, listOceanECSLaunchSpecsForGenerate},
+	{commons.OceanGKEImportResourceName, listOceanGKEForGenerate},
+	{commons.OceanGKELaunchSpecResourceName, listOceanGKELaunchSpecsForGenerate},
+	{commons.OceanAKSNPResourceName, listOceanAKSNPForGenerate},
+	{commons.OceanAKSNPVirtualNodeGroupResourceName, listOceanAKSNPVirtualNodeGroupsForGenerate},
+	{commons.ManagedInstanceAWSResourceName, listManagedInstanceAWSForGenerate},
+}
+
+// GenerateResourceTypes returns the resource types supported by Generate.
+func GenerateResourceTypes() []string {
+	types := make([]string, 0, len(generateResourceTypes))
+	for _, t := range generateResourceTypes {
+		types = append(types, string(t.resourceName))
+	}
+	return types
+}
+
+// Generate writes Terraform configuration, together with matching `import`
+// blocks, for the resources found in the Spotinst account. Each resource is
+// read through its own importer and read function, so the generated
+// configuration is based on the same fields the resource registers in its
+// Setup.
+func Generate(ctx context.Context, w io.Writer, opts *GenerateOptions) error {
+	provider := Provider()
+
+	config := Config{
+		Enabled:          true,
+		Token:            opts.Token,
+		Account:          opts.Account,
+		terraformVersion: "generate",
+	}
+	client, diags := config.Client()
+	if diags.HasError() {
+		return fmt.Errorf("failed to configure client: %v", diags[0].Summary)
+	}
+
+	wanted := make(map[string]bool)
+	for _, t := range opts.ResourceTypes {
+		if _, ok := provider.ResourcesMap[t]; !ok {
+			return fmt.Errorf("unknown resource type %q", t)
+		}
+		wanted[t] = true
+	}
+
+	g := &generator{
+		ctx:       ctx,
+		client:    client,
+		file:      hclwrite.NewEmptyFile(),
+		names:     make(map[string]bool),
+		addresses: make(map[string]hcl.Traversal),
+	}
+
+	for _, t := range generateResourceTypes {
+		resourceType := string(t.resourceName)
+		if len(wanted) > 0 && !wanted[resourceType] {
+			continue
+		}
+
+		objects, err := t.list(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to list %s: %v", resourceType, err)
+		}
+
+		log.Printf("[INFO] Generating configuration for %d %s resources", len(objects), resourceType)
+		for _, object := range objects {
+			if err := g.generate(resourceType, provider.ResourcesMap[resourceType], object); err != nil {
+				return fmt.Errorf("failed to generate %s %q: %v", resourceType, object.ID, err)
+			}
+		}
+	}
+
+	_, err := g.file.WriteTo(w)
+	return err
+}
+
+type generator struct {
+	ctx    context.Context
+	client *Client
+	file   *hclwrite.File
+
+	// names holds the addresses already in use.
+	names map[string]bool
+
+	// addresses maps the IDs of generated resources to their addresses, so
+	// that attributes holding those IDs can be written as references.
+	addresses map[string]hcl.Traversal
+
+	// variables holds the variables referenced by the sensitive attributes
+	// of the resource being generated.
+	variables []string
+}
+
+func (g *generator) generate(resourceType string, res *schema.Resource, object commons.NamedResource) error {
+	resourceData := res.Data(nil)
+	resourceData.SetId(object.ID)
+
+	if res.Importer != nil && res.Importer.StateContext != nil {
+		imported, err := res.Importer.StateContext(g.ctx, resourceData, g.client)
+		if err != nil {
+			return err
+		}
+		if len(imported) == 0 {
+			return nil
+		}
+		resourceData = imported[0]
+	}
+
+	if diags := res.ReadContext(g.ctx, resourceData, g.client); diags.HasError() {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+	if resourceData.Id() == "" {
+		log.Printf("[WARN] %s %q no longer exists, skipping", resourceType, object.ID)
+		return nil
+	}
+
+	name := g.resourceName(resourceType, object)
+	address := hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	}
+
+	body := g.file.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+
+	importBody := body.AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", address)
+	importBody.SetAttributeValue("id", cty.StringVal(resourceData.Id()))
+	body.AppendNewline()
+
+	resourceBody := body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+	g.variables = nil
+	g.writeBody(resourceBody, res.Schema, name, func(key string) interface{} {
+		return resourceData.Get(key)
+	})
+	for _, variable := range g.variables {
+		body.AppendNewline()
+		variableBody := body.AppendNewBlock("variable", []string{variable}).Body()
+		variableBody.SetAttributeValue("sensitive", cty.True)
+	}
+
+	g.addresses[resourceData.Id()] = append(address, hcl.TraverseAttr{Name: "id"})
+	return nil
+}
+
+// writeBody writes the attributes and nested blocks of schemaMap whose values
+// differ from what Terraform would assume if they were left out. Sensitive
+// attributes are never written in plaintext: they reference a variable named
+// after path and the attribute instead.
+func (g *generator) writeBody(body *hclwrite.Body, schemaMap map[string]*schema.Schema, path string, get func(key string) interface{}) bool {
+	keys := make([]string, 0, len(schemaMap))
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var blocks []string
+	written := false
+	for _, key := range keys {
+		s := schemaMap[key]
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			blocks = append(blocks, key)
+			continue
+		}
+
+		value := normalizeGenerateValue(get(key))
+		if !shouldGenerateAttribute(s, value) {
+			continue
+		}
+
+		if s.Sensitive {
+			variable := path + "_" + key
+			body.SetAttributeTraversal(key, hcl.Traversal{
+				hcl.TraverseRoot{Name: "var"},
+				hcl.TraverseAttr{Name: variable},
+			})
+			g.variables = append(g.variables, variable)
+			written = true
+			continue
+		}
+
+		if id, ok := value.(string); ok {
+			if ref, ok := g.addresses[id]; ok {
+				body.SetAttributeTraversal(key, ref)
+				written = true
+				continue
+			}
+		}
+
+		body.SetAttributeValue(key, generateValue(value))
+		written = true
+	}
+
+	for _, key := range blocks {
+		s := schemaMap[key]
+		if !isGeneratedSchema(s) {
+			continue
+		}
+
+		elem := s.Elem.(*schema.Resource)
+		items, _ := normalizeGenerateValue(get(key)).([]interface{})
+		for i, item := range items {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			block := hclwrite.NewBlock(key, nil)
+			blockPath := fmt.Sprintf("%s_%s_%d", path, key, i)
+			if g.writeBody(block.Body(), elem.Schema, blockPath, func(key string) interface{} { return m[key] }) {
+				body.AppendBlock(block)
+				written = true
+			}
+		}
+	}
+
+	return written
+}
+
+// resourceName returns a unique Terraform resource name for object.
+func (g *generator) resourceName(resourceType string, object commons.NamedResource) string {
+	base := object.Name
+	if base == "" {
+		base = object.ID
+	}
+
+	base = strings.Trim(invalidGenerateNameChars.ReplaceAllString(strings.ToLower(base), "_"), "_")
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "r_" + base
+	}
+
+	name := base
+	for i := 2; g.names[resourceType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	g.names[resourceType+"."+name] = true
+
+	return name
+}
+
+var invalidGenerateNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+func isGeneratedSchema(s *schema.Schema) bool {
+	if s.Computed && !s.Optional && !s.Required {
+		return false
+	}
+	return s.Deprecated == ""
+}
+
+func shouldGenerateAttribute(s *schema.Schema, value interface{}) bool {
+	if !isGeneratedSchema(s) {
+		return false
+	}
+	if s.Required {
+		return true
+	}
+	if s.Default != nil {
+		return !reflect.DeepEqual(value, s.Default)
+	}
+	return !isZeroGenerateValue(value)
+}
+
+func normalizeGenerateValue(value interface{}) interface{} {
+	if set, ok := value.(*schema.Set); ok {
+		return set.List()
+	}
+	return value
+}
+
+func isZeroGenerateValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+func generateValue(value interface{}) cty.Value {
+	switch v := normalizeGenerateValue(value).(type) {
+	case string:
+		return cty.StringVal(v)
+	case int:
+		return cty.NumberIntVal(int64(v))
+	case float64:
+		return cty.NumberFloatVal(v)
+	case bool:
+		return cty.BoolVal(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return cty.EmptyTupleVal
+		}
+		values := make([]cty.Value, 0, len(v))
+		for _, item := range v {
+			values = append(values, generateValue(item))
+		}
+		return cty.TupleVal(values)
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return cty.EmptyObjectVal
+		}
+		values := make(map[string]cty.Value, len(v))
+		for key, item := range v {
+			values[key] = generateValue(item)
+		}
+		return cty.ObjectVal(values)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
+
+func listElastigroupAWSForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.elastigroup.CloudProviderAWS().List(ctx, &elastigroupAWS.ListGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]commons.NamedResource, 0, len(resp.Groups))
+	for _, group := range resp.Groups {
+		groups = append(groups, commons.NamedResource{
+			ID:   spotinst.StringValue(group.ID),
+			Name: spotinst.StringValue(group.Name),
+		})
+	}
+	return groups, nil
+}
+
+func listOceanAWSForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderAWS().ListClusters(ctx, &aws.ListClustersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]commons.NamedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, commons.NamedResource{
+			ID:   spotinst.StringValue(cluster.ID),
+			Name: spotinst.StringValue(cluster.Name),
+		})
+	}
+	return clusters, nil
+}
+
+func listOceanAWSLaunchSpecsForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderAWS().ListLaunchSpecs(ctx, &aws.ListLaunchSpecsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	launchSpecs := make([]commons.NamedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, commons.NamedResource{
+			ID:   spotinst.StringValue(launchSpec.ID),
+			Name: spotinst.StringValue(launchSpec.Name),
+		})
+	}
+	return launchSpecs, nil
+}
+
+func listOceanECSForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderAWS().ListECSClusters(ctx, &aws.ListECSClustersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]commons.NamedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, commons.NamedResource{
+			ID:   spotinst.StringValue(cluster.ID),
+			Name: spotinst.StringValue(cluster.Name),
+		})
+	}
+	return clusters, nil
+}
+
+func listOceanECSLaunchSpecsForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderAWS().ListECSLaunchSpecs(ctx, &aws.ListECSLaunchSpecsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	launchSpecs := make([]commons.NamedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, commons.NamedResource{
+			ID:   spotinst.StringValue(launchSpec.ID),
+			Name: spotinst.StringValue(launchSpec.Name),
+		})
+	}
+	return launchSpecs, nil
+}
+
+func listOceanGKEForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderGCP().ListClusters(ctx, &gcp.ListClustersInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]commons.NamedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, commons.NamedResource{
+			ID:   spotinst.StringValue(cluster.ID),
+			Name: spotinst.StringValue(cluster.Name),
+		})
+	}
+	return clusters, nil
+}
+
+func listOceanGKELaunchSpecsForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderGCP().ListLaunchSpecs(ctx, &gcp.ListLaunchSpecsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	launchSpecs := make([]commons.NamedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, commons.NamedResource{
+			ID:   spotinst.StringValue(launchSpec.ID),
+			Name: spotinst.StringValue(launchSpec.Name),
+		})
+	}
+	return launchSpecs, nil
+}
+
+func listOceanAKSNPForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderAzureNP().ListClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]commons.NamedResource, 0, len(resp.Clusters))
+	for _, cluster := range resp.Clusters {
+		clusters = append(clusters, commons.NamedResource{
+			ID:   spotinst.StringValue(cluster.ID),
+			Name: spotinst.StringValue(cluster.Name),
+		})
+	}
+	return clusters, nil
+}
+
+func listOceanAKSNPVirtualNodeGroupsForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.ocean.CloudProviderAzureNP().ListVirtualNodeGroups(ctx, &azure_np.ListVirtualNodeGroupsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	virtualNodeGroups := make([]commons.NamedResource, 0, len(resp.VirtualNodeGroups))
+	for _, virtualNodeGroup := range resp.VirtualNodeGroups {
+		virtualNodeGroups = append(virtualNodeGroups, commons.NamedResource{
+			ID:   spotinst.StringValue(virtualNodeGroup.ID),
+			Name: spotinst.StringValue(virtualNodeGroup.Name),
+		})
+	}
+	return virtualNodeGroups, nil
+}
+
+func listManagedInstanceAWSForGenerate(ctx context.Context, client *Client) ([]commons.NamedResource, error) {
+	resp, err := client.managedInstance.CloudProviderAWS().List(ctx, &managedInstanceAWS.ListManagedInstancesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	managedInstances := make([]commons.NamedResource, 0, len(resp.ManagedInstances))
+	for _, managedInstance := range resp.ManagedInstances {
+		managedInstances = append(managedInstances, commons.NamedResource{
+			ID:   spotinst.StringValue(managedInstance.ID),
+			Name: spotinst.StringValue(managedInstance.Name),
+		})
+	}
+	return managedInstances, nil
+}
@@ -0,0 +1,313 @@
+package spotinst
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// testGenerateResource covers the kinds of fields the generated resources
+// have: required, optional with and without defaults, computed, deprecated,
+// sensitive and nested blocks.
+func testGenerateResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":        {Type: schema.TypeString, Required: true},
+			"description": {Type: schema.TypeString, Optional: true},
+			"capacity":    {Type: schema.TypeInt, Optional: true, Default: 1},
+			"enabled":     {Type: schema.TypeBool, Optional: true},
+			"zones":       {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"status":      {Type: schema.TypeString, Computed: true},
+			"old_name":    {Type: schema.TypeString, Optional: true, Deprecated: "use name"},
+			"token":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"integration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host":       {Type: schema.TypeString, Required: true},
+						"access_key": {Type: schema.TypeString, Required: true, Sensitive: true},
+					},
+				},
+			},
+			"scaling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minimum": {Type: schema.TypeInt, Optional: true},
+						"maximum": {Type: schema.TypeInt, Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testWriteGenerateBody(t *testing.T, g *generator, values map[string]interface{}) string {
+	res := testGenerateResource()
+	resourceData := res.Data(nil)
+	for key, s := range res.Schema {
+		if s.Default != nil {
+			if err := resourceData.Set(key, s.Default); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for key, value := range values {
+		if err := resourceData.Set(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file := hclwrite.NewEmptyFile()
+	g.writeBody(file.Body(), res.Schema, "example", func(key string) interface{} {
+		return resourceData.Get(key)
+	})
+	return string(file.Bytes())
+}
+
+func TestGenerateWriteBody(t *testing.T) {
+	cases := []struct {
+		name     string
+		values   map[string]interface{}
+		contains []string
+		excludes []string
+		vars     []string
+	}{
+		{
+			name:     "defaults are left out",
+			values:   map[string]interface{}{"name": "web", "capacity": 1, "enabled": false, "zones": []interface{}{}},
+			contains: []string{`name = "web"`},
+			excludes: []string{"capacity", "enabled", "zones"},
+		},
+		{
+			name:     "values that differ from the defaults",
+			values:   map[string]interface{}{"name": "web", "capacity": 3, "enabled": true, "zones": []interface{}{"a", "b"}},
+			contains: []string{"capacity = 3", "enabled  = true", `zones    = ["a", "b"]`},
+		},
+		{
+			name:     "computed and deprecated fields are left out",
+			values:   map[string]interface{}{"name": "web", "status": "ACTIVE", "old_name": "web"},
+			excludes: []string{"status", "old_name"},
+		},
+		{
+			name:     "sensitive values reference variables",
+			values:   map[string]interface{}{"name": "web", "token": "s3cr3t"},
+			contains: []string{"token = var.example_token"},
+			excludes: []string{"s3cr3t"},
+			vars:     []string{"example_token"},
+		},
+		{
+			name: "required sensitive values in blocks reference variables",
+			values: map[string]interface{}{
+				"name": "web",
+				"integration": []interface{}{
+					map[string]interface{}{"host": "a.example.com", "access_key": "k1"},
+					map[string]interface{}{"host": "b.example.com", "access_key": ""},
+				},
+			},
+			contains: []string{"access_key = var.example_integration_0_access_key", "access_key = var.example_integration_1_access_key"},
+			excludes: []string{"k1"},
+			vars:     []string{"example_integration_0_access_key", "example_integration_1_access_key"},
+		},
+		{
+			name: "empty blocks are left out",
+			values: map[string]interface{}{
+				"name":    "web",
+				"scaling": []interface{}{map[string]interface{}{"minimum": 0, "maximum": 0}},
+			},
+			excludes: []string{"scaling"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := &generator{addresses: make(map[string]hcl.Traversal)}
+			out := testWriteGenerateBody(t, g, c.values)
+			for _, s := range c.contains {
+				if !strings.Contains(out, s) {
+					t.Errorf("expected %q in:\n%s", s, out)
+				}
+			}
+			for _, s := range c.excludes {
+				if strings.Contains(out, s) {
+					t.Errorf("unexpected %q in:\n%s", s, out)
+				}
+			}
+			if strings.Join(g.variables, ",") != strings.Join(c.vars, ",") {
+				t.Errorf("expected variables %v, got %v", c.vars, g.variables)
+			}
+		})
+	}
+}
+
+func TestGenerateWriteBodyReferences(t *testing.T) {
+	g := &generator{addresses: map[string]hcl.Traversal{
+		"sig-1234": {hcl.TraverseRoot{Name: "spotinst_elastigroup_aws"}, hcl.TraverseAttr{Name: "web"}, hcl.TraverseAttr{Name: "id"}},
+	}}
+	out := testWriteGenerateBody(t, g, map[string]interface{}{"name": "sig-1234"})
+	if !strings.Contains(out, "name = spotinst_elastigroup_aws.web.id") {
+		t.Errorf("expected a reference in:\n%s", out)
+	}
+}
+
+func TestShouldGenerateAttribute(t *testing.T) {
+	s := testGenerateResource().Schema
+	cases := []struct {
+		key      string
+		value    interface{}
+		expected bool
+	}{
+		{"name", "", true},
+		{"description", "", false},
+		{"description", "web servers", true},
+		{"capacity", 1, false},
+		{"capacity", 2, true},
+		{"enabled", false, false},
+		{"enabled", true, true},
+		{"zones", []interface{}{}, false},
+		{"zones", []interface{}{"a"}, true},
+		{"status", "ACTIVE", false},
+		{"old_name", "web", false},
+		{"token", "", false},
+		{"token", "s3cr3t", true},
+	}
+	for _, c := range cases {
+		if got := shouldGenerateAttribute(s[c.key], c.value); got != c.expected {
+			t.Errorf("%s = %#v: expected %v, got %v", c.key, c.value, c.expected, got)
+		}
+	}
+}
+
+func TestGenerateValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		expected cty.Value
+	}{
+		{"string", "web", cty.StringVal("web")},
+		{"int", 3, cty.NumberIntVal(3)},
+		{"float", 0.5, cty.NumberFloatVal(0.5)},
+		{"bool", true, cty.True},
+		{"empty list", []interface{}{}, cty.EmptyTupleVal},
+		{"list", []interface{}{"a", 1}, cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.NumberIntVal(1)})},
+		{"set", schema.NewSet(schema.HashString, []interface{}{"a"}), cty.TupleVal([]cty.Value{cty.StringVal("a")})},
+		{"empty map", map[string]interface{}{}, cty.EmptyObjectVal},
+		{"map", map[string]interface{}{"team": "web"}, cty.ObjectVal(map[string]cty.Value{"team": cty.StringVal("web")})},
+		{"unsupported", struct{}{}, cty.NullVal(cty.DynamicPseudoType)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := generateValue(c.value); !got.RawEquals(c.expected) {
+				t.Errorf("expected %#v, got %#v", c.expected, got)
+			}
+		})
+	}
+}
+
+// TestGenerateRoundTrip checks that the configuration generated from a
+// resource's state plans no changes against that state.
+func TestGenerateRoundTrip(t *testing.T) {
+	res := testGenerateResource()
+	delete(res.Schema, "token")
+	delete(res.Schema, "integration")
+
+	resourceData := res.Data(nil)
+	resourceData.SetId("r-1234")
+	for key, value := range map[string]interface{}{
+		"name":        "web",
+		"description": "web servers",
+		"capacity":    1,
+		"enabled":     true,
+		"zones":       []interface{}{"a", "b"},
+		"scaling":     []interface{}{map[string]interface{}{"minimum": 1, "maximum": 0}},
+	} {
+		if err := resourceData.Set(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	state := resourceData.State()
+
+	g := &generator{addresses: make(map[string]hcl.Traversal)}
+	file := hclwrite.NewEmptyFile()
+	g.writeBody(file.Body(), res.Schema, "web", func(key string) interface{} {
+		return resourceData.Get(key)
+	})
+
+	parsed, diags := hclsyntax.ParseConfig(file.Bytes(), "generated.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("generated configuration does not parse: %s\n%s", diags, file.Bytes())
+	}
+	config, err := testGenerateBodyToMap(parsed.Body.(*hclsyntax.Body))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected an empty plan, got %#v for:\n%s", diff.Attributes, file.Bytes())
+	}
+}
+
+func testGenerateBodyToMap(body *hclsyntax.Body) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for name, attr := range body.Attributes {
+		value, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		result[name] = testGenerateCtyToGo(value)
+	}
+	for _, block := range body.Blocks {
+		m, err := testGenerateBodyToMap(block.Body)
+		if err != nil {
+			return nil, err
+		}
+		items, _ := result[block.Type].([]interface{})
+		result[block.Type] = append(items, m)
+	}
+	return result, nil
+}
+
+func testGenerateCtyToGo(value cty.Value) interface{} {
+	switch {
+	case value.Type() == cty.String:
+		return value.AsString()
+	case value.Type() == cty.Number:
+		if i, accuracy := value.AsBigFloat().Int64(); accuracy == big.Exact {
+			return int(i)
+		}
+		f, _ := value.AsBigFloat().Float64()
+		return f
+	case value.Type() == cty.Bool:
+		return value.True()
+	case value.Type().IsTupleType() || value.Type().IsListType():
+		items := make([]interface{}, 0, value.LengthInt())
+		for it := value.ElementIterator(); it.Next(); {
+			_, v := it.Element()
+			items = append(items, testGenerateCtyToGo(v))
+		}
+		return items
+	case value.Type().IsObjectType() || value.Type().IsMapType():
+		m := make(map[string]interface{})
+		for it := value.ElementIterator(); it.Next(); {
+			k, v := it.Element()
+			m[k.AsString()] = testGenerateCtyToGo(v)
+		}
+		return m
+	}
+	return nil
+}
@@ -16,6 +16,10 @@ const (
 	Unhealthy  commons.FieldName = "unhealthy"
 	Healthy    commons.FieldName = "healthy"
 
+	HealthCheckID        commons.FieldName = "health_check_id"
+	HealthyInstanceCount commons.FieldName = "healthy_instance_count"
+	UnhealthyInstances   commons.FieldName = "unhealthy_instances"
+
 	// Deprecated: EndPoint is obsolete, exists for backward compatibility only,
 	// and should not be used. Please use Endpoint instead.
 	EndPoint commons.FieldName = "end_point"
@@ -24,3 +28,14 @@ const (
 	// and should not be used. Please use Timeout instead.
 	TimeOut commons.FieldName = "time_out"
 )
+
+var (
+	checkProtocols = []string{"http", "https", "tcp"}
+
+	healthyStatus   = "HEALTHY"
+	unhealthyStatus = "UNHEALTHY"
+)
+
+// ElastigroupIDPrefix is the prefix of Elastigroup IDs, the only resources
+// whose instance health status can be read.
+const ElastigroupIDPrefix = "sig-"
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -161,12 +163,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		&schema.Schema{
 			Type:     schema.TypeList,
 			Optional: true,
-			MaxItems: 1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(Protocol): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(checkProtocols, true),
 					},
 
 					string(Port): {
@@ -175,13 +177,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Endpoint): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: commons.SuppressDeprecatedAttributeDiff(string(EndPoint)),
 					},
 
 					string(EndPoint): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: commons.SuppressDeprecatedAttributeDiff(string(Endpoint)),
 					},
 
 					string(Interval): {
@@ -190,13 +194,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(Timeout): {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:             schema.TypeInt,
+						Optional:         true,
+						DiffSuppressFunc: commons.SuppressDeprecatedAttributeDiff(string(TimeOut)),
 					},
 
 					string(TimeOut): {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:             schema.TypeInt,
+						Optional:         true,
+						DiffSuppressFunc: commons.SuppressDeprecatedAttributeDiff(string(Timeout)),
 					},
 
 					string(Unhealthy): {
@@ -208,6 +214,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeInt,
 						Required: true,
 					},
+
+					string(HealthCheckID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
 				},
 			},
 		},
@@ -241,14 +252,113 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			return nil
 		},
 		nil,
+	).AddStateUpgrader(0, func(rawState map[string]interface{}) error {
+		// Version 1 moves the values of the deprecated end_point and
+		// time_out attributes to endpoint and timeout.
+		for _, check := range commons.StateBlocks(rawState, string(Check)) {
+			commons.CopyStateAttribute(check, string(EndPoint), string(Endpoint))
+			commons.CopyStateAttribute(check, string(TimeOut), string(Timeout))
+		}
+		return nil
+	})
+
+	fieldsMap[HealthyInstanceCount] = commons.NewGenericField(
+		commons.HealthCheck,
+		HealthyInstanceCount,
+		&schema.Schema{
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		// The instance health status is read by the resource from the API of
+		// the checked resource.
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[UnhealthyInstances] = commons.NewGenericField(
+		commons.HealthCheck,
+		UnhealthyInstances,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		nil,
+		nil,
+		nil,
+		nil,
 	)
+}
 
+// ResourceCheck is a block of the check list, along with the ID of the
+// health check created for it.
+type ResourceCheck struct {
+	HealthCheckID string
+	Check         *healthcheck.Check
+}
+
+// ExpandChecks expands the check list. The first check is the one of the
+// resource itself, every other check is a health check of its own.
+func ExpandChecks(data interface{}) ([]*ResourceCheck, error) {
+	list, _ := data.([]interface{})
+	checks := make([]*ResourceCheck, 0, len(list))
+	for _, item := range list {
+		check, err := expandCheck([]interface{}{item})
+		if err != nil {
+			return nil, err
+		}
+		resourceCheck := &ResourceCheck{Check: check}
+		if m, ok := item.(map[string]interface{}); ok {
+			resourceCheck.HealthCheckID, _ = m[string(HealthCheckID)].(string)
+		}
+		checks = append(checks, resourceCheck)
+	}
+	return checks, nil
+}
+
+// SetCheckIDs returns a copy of the check list whose blocks carry the given
+// health check IDs. Blocks whose ID is empty are dropped, so that their
+// health checks are created again.
+func SetCheckIDs(data interface{}, ids []string) []interface{} {
+	list, _ := data.([]interface{})
+	result := make([]interface{}, 0, len(list))
+	for i, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok || i >= len(ids) || ids[i] == "" {
+			continue
+		}
+		block := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			block[k] = v
+		}
+		block[string(HealthCheckID)] = ids[i]
+		result = append(result, block)
+	}
+	return result
+}
+
+// InstanceHealthStatus returns the number of healthy instances and the IDs
+// of the unhealthy ones.
+func InstanceHealthStatus(instances []*aws.InstanceHealth) (int, []string) {
+	healthy := 0
+	unhealthy := make([]string, 0)
+	for _, instance := range instances {
+		switch spotinst.StringValue(instance.HealthStatus) {
+		case healthyStatus:
+			healthy++
+		case unhealthyStatus:
+			unhealthy = append(unhealthy, spotinst.StringValue(instance.InstanceID))
+		}
+	}
+	return healthy, unhealthy
 }
 
 func expandCheck(data interface{}) (*healthcheck.Check, error) {
 	check := &healthcheck.Check{}
 	list := data.([]interface{})
-	if list == nil || list[0] == nil {
+	if len(list) == 0 || list[0] == nil {
 		return check, nil
 	}
 	m := list[0].(map[string]interface{})
@@ -0,0 +1,208 @@
+// Package instance_catalog provides an embedded catalog of the instance
+// types, machine types and VM sizes that Ocean can launch, used to validate
+// instance-type settings at plan time and to back the instance-type data
+// sources.
+//
+// The catalog files are regenerated by scripts/update-instance-catalog.sh,
+// which marks them complete. The embedded files only list a sample of common
+// types, so the checks that rely on a type being absent from the catalog are
+// skipped for them. A directory holding generated files can be used without a
+// new provider release by pointing SPOTINST_INSTANCE_CATALOG_DIR at it.
+package instance_catalog
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// EnvCatalogDir is the environment variable naming a directory with catalog
+// files that take precedence over the embedded ones.
+const EnvCatalogDir = "SPOTINST_INSTANCE_CATALOG_DIR"
+
+type Cloud string
+
+const (
+	AWS   Cloud = "aws"
+	GCP   Cloud = "gcp"
+	Azure Cloud = "azure"
+)
+
+//go:embed aws.json gcp.json azure.json
+var embedded embed.FS
+
+// InstanceType describes a single instance type, machine type or VM size.
+// Optional attributes are nil or empty when the catalog does not know them,
+// in which case filters on them are not applied.
+type InstanceType struct {
+	Name                  string  `json:"name"`
+	Family                string  `json:"family"`
+	Category              string  `json:"category"`
+	Architecture          string  `json:"architecture"`
+	VCPU                  int     `json:"vcpu"`
+	MemoryGiB             float64 `json:"memoryGiB"`
+	GPU                   int     `json:"gpu,omitempty"`
+	GPUType               string  `json:"gpuType,omitempty"`
+	Metal                 bool    `json:"metal,omitempty"`
+	Hypervisor            string  `json:"hypervisor,omitempty"`
+	EnaSupported          *bool   `json:"enaSupported,omitempty"`
+	AcceleratedNetworking *bool   `json:"acceleratedNetworking,omitempty"`
+	PremiumStorage        *bool   `json:"premiumStorage,omitempty"`
+	Spot                  *bool   `json:"spot,omitempty"`
+}
+
+type Catalog struct {
+	Cloud         Cloud           `json:"provider"`
+	UpdatedAt     string          `json:"updatedAt"`
+	Complete      bool            `json:"complete,omitempty"`
+	InstanceTypes []*InstanceType `json:"instanceTypes"`
+
+	byName   map[string]*InstanceType
+	families map[string]bool
+}
+
+var catalogs = struct {
+	sync.Mutex
+	loaded map[Cloud]*Catalog
+}{loaded: make(map[Cloud]*Catalog)}
+
+// Load returns the catalog of a cloud, reading it once per process.
+func Load(cloud Cloud) (*Catalog, error) {
+	catalogs.Lock()
+	defer catalogs.Unlock()
+
+	if c, ok := catalogs.loaded[cloud]; ok {
+		return c, nil
+	}
+
+	file := string(cloud) + ".json"
+	var data []byte
+	var err error
+	if dir := os.Getenv(EnvCatalogDir); dir != "" {
+		data, err = os.ReadFile(filepath.Join(dir, file))
+	} else {
+		data, err = embedded.ReadFile(file)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the %s instance type catalog: %v", cloud, err)
+	}
+
+	c, err := parse(cloud, data)
+	if err != nil {
+		return nil, err
+	}
+	catalogs.loaded[cloud] = c
+	return c, nil
+}
+
+func parse(cloud Cloud, data []byte) (*Catalog, error) {
+	c := &Catalog{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse the %s instance type catalog: %v", cloud, err)
+	}
+	c.Cloud = cloud
+	c.index()
+	return c, nil
+}
+
+func (c *Catalog) index() {
+	c.byName = make(map[string]*InstanceType, len(c.InstanceTypes))
+	c.families = make(map[string]bool)
+	for _, t := range c.InstanceTypes {
+		c.byName[t.Name] = t
+		c.families[strings.ToLower(t.Family)] = true
+	}
+}
+
+// Lookup returns the instance type with the given name, or nil.
+func (c *Catalog) Lookup(name string) *InstanceType {
+	return c.byName[name]
+}
+
+// Filter returns the names of the instance types accepted by match, sorted.
+func (c *Catalog) Filter(match func(*InstanceType) bool) []string {
+	var names []string
+	for _, t := range c.InstanceTypes {
+		if match(t) {
+			names = append(names, t.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasFamily reports whether the catalog knows the family of instance types.
+func (c *Catalog) HasFamily(family string) bool {
+	return c.families[strings.ToLower(family)]
+}
+
+// familyOf derives the family from an instance type name, e.g. "m5" from
+// "m5.large" or "n2" from "n2-standard-4". Azure VM size names do not encode
+// their series in a way that can be split off, so it returns "" for them.
+func (c *Catalog) familyOf(name string) string {
+	var sep string
+	switch c.Cloud {
+	case AWS:
+		sep = "."
+	case GCP:
+		sep = "-"
+	default:
+		return ""
+	}
+	if i := strings.Index(name, sep); i > 0 {
+		return name[:i]
+	}
+	return ""
+}
+
+// UnknownTypes returns the names that are missing from the catalog although
+// their family is in it, which almost always means a typo. Names of families
+// the catalog does not know yet are accepted, so a stale catalog does not
+// reject new instance types, and so are GKE custom machine types, which no
+// catalog lists. Only complete catalogs are checked.
+func (c *Catalog) UnknownTypes(names []string) []string {
+	if !c.Complete {
+		return nil
+	}
+
+	var unknown []string
+	for _, name := range names {
+		if c.Lookup(name) != nil {
+			continue
+		}
+		if c.Cloud == GCP && strings.Contains(name, "-custom-") {
+			continue
+		}
+		if family := c.familyOf(name); family != "" && c.HasFamily(family) {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown
+}
+
+// UnknownTypesError describes the names returned by UnknownTypes.
+func (c *Catalog) UnknownTypesError(field string, unknown []string) error {
+	return fmt.Errorf("%s: %s not in the %s instance type catalog (updated %s), check for typos",
+		field, strings.Join(unknown, ", "), c.Cloud, c.UpdatedAt)
+}
+
+// NoMatchError describes filters that match no type in a complete catalog.
+func (c *Catalog) NoMatchError(field string) error {
+	return fmt.Errorf("%s: no types in the %s instance type catalog (updated %s) match",
+		field, c.Cloud, c.UpdatedAt)
+}
+
+// NoMatchWarning describes filters that match no type in the catalog. Unless
+// the catalog is complete, they may still match types it does not list.
+func (c *Catalog) NoMatchWarning(field string) string {
+	warning := c.NoMatchError(field).Error()
+	if !c.Complete {
+		warning += ", the filters may still match types the catalog does not list"
+	}
+	return warning
+}
@@ -0,0 +1,147 @@
+package instance_catalog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+func TestMatchAWSFilters(t *testing.T) {
+	m5 := &InstanceType{Name: "m5.large", Family: "m5", Category: "General_purpose", Architecture: "x86_64", VCPU: 2, MemoryGiB: 8, Hypervisor: "nitro", EnaSupported: spotinst.Bool(true)}
+	metal := &InstanceType{Name: "m5.metal", Family: "m5", Category: "General_purpose", Architecture: "x86_64", VCPU: 96, MemoryGiB: 384, Metal: true}
+	p3 := &InstanceType{Name: "p3.2xlarge", Family: "p3", Category: "Accelerated_computing", Architecture: "x86_64", VCPU: 8, MemoryGiB: 61, GPU: 1}
+
+	cases := []struct {
+		name     string
+		t        *InstanceType
+		filters  *aws.Filters
+		expected bool
+	}{
+		{"no filters", m5, &aws.Filters{}, true},
+		{"architecture ignores case", m5, &aws.Filters{Architectures: []string{"X86_64"}}, true},
+		{"other architecture", m5, &aws.Filters{Architectures: []string{"arm64"}}, false},
+		{"category", p3, &aws.Filters{Categories: []string{"Accelerated_computing"}}, true},
+		{"other category", m5, &aws.Filters{Categories: []string{"Accelerated_computing"}}, false},
+		{"include family wildcard", m5, &aws.Filters{IncludeFamilies: []string{"M*"}}, true},
+		{"include other family", m5, &aws.Filters{IncludeFamilies: []string{"c*"}}, false},
+		{"exclude family", m5, &aws.Filters{ExcludeFamilies: []string{"m5"}}, false},
+		{"exclude metal", metal, &aws.Filters{ExcludeMetal: spotinst.Bool(true)}, false},
+		{"hypervisor", m5, &aws.Filters{Hypervisor: []string{"xen"}}, false},
+		{"unknown hypervisor is not checked", p3, &aws.Filters{Hypervisor: []string{"xen"}}, true},
+		{"ena", m5, &aws.Filters{IsEnaSupported: spotinst.Bool(false)}, false},
+		{"vcpu range", m5, &aws.Filters{MinVcpu: spotinst.Int(2), MaxVcpu: spotinst.Int(4)}, true},
+		{"below min vcpu", m5, &aws.Filters{MinVcpu: spotinst.Int(4)}, false},
+		{"above max memory", metal, &aws.Filters{MaxMemoryGiB: spotinst.Float64(256)}, false},
+		{"min gpu", p3, &aws.Filters{MinGpu: spotinst.Int(1)}, true},
+		{"no gpu", m5, &aws.Filters{MinGpu: spotinst.Int(1)}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := MatchAWSFilters(c.t, c.filters); got != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestResolveAWSFilters(t *testing.T) {
+	catalog, err := Load(AWS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		filters *aws.Filters
+		empty   bool
+		err     string
+	}{
+		{"matches", &aws.Filters{IncludeFamilies: []string{"m5"}, MaxVcpu: spotinst.Int(4)}, false, ""},
+		{"more vcpus than the catalog lists", &aws.Filters{MinVcpu: spotinst.Int(256)}, true, ""},
+		{"category missing from the catalog", &aws.Filters{Categories: []string{"High_performance_computing"}}, true, ""},
+		{"vcpu range", &aws.Filters{MinVcpu: spotinst.Int(8), MaxVcpu: spotinst.Int(4)}, false, "min_vcpu (8) is greater than max_vcpu (4)"},
+		{"memory range", &aws.Filters{MinMemoryGiB: spotinst.Float64(8), MaxMemoryGiB: spotinst.Float64(4)}, false, "min_memory_gib"},
+		{"gpu range", &aws.Filters{MinGpu: spotinst.Int(2), MaxGpu: spotinst.Int(1)}, false, "min_gpu"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			names, err := ResolveAWSFilters(catalog, c.filters)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Fatalf("expected error containing %q, got %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (len(names) == 0) != c.empty {
+				t.Errorf("expected empty result %v, got %v", c.empty, names)
+			}
+			for _, name := range names {
+				if !MatchAWSFilters(catalog.Lookup(name), c.filters) {
+					t.Errorf("%s does not match the filters", name)
+				}
+			}
+		})
+	}
+}
+
+func TestUnknownTypes(t *testing.T) {
+	awsCatalog, err := parse(AWS, []byte(`{"complete": true, "updatedAt": "2026-10-01", "instanceTypes": [
+		{"name": "m5.large", "family": "m5"},
+		{"name": "c5.xlarge", "family": "c5"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gcpCatalog, err := parse(GCP, []byte(`{"complete": true, "instanceTypes": [{"name": "n2-standard-4", "family": "n2"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	embedded, err := Load(AWS)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		catalog  *Catalog
+		names    []string
+		expected []string
+	}{
+		{"known", awsCatalog, []string{"m5.large", "c5.xlarge"}, nil},
+		{"typo in a known family", awsCatalog, []string{"m5.larg"}, []string{"m5.larg"}},
+		{"unknown family", awsCatalog, []string{"x9z.large"}, nil},
+		{"gke typo", gcpCatalog, []string{"n2-standrd-4"}, []string{"n2-standrd-4"}},
+		{"gke custom machine type", gcpCatalog, []string{"n2-custom-4-16384"}, nil},
+		{"catalog that is not complete", embedded, []string{"m5.larg"}, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.catalog.UnknownTypes(c.names)
+			if strings.Join(got, ",") != strings.Join(c.expected, ",") {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+
+	err = awsCatalog.UnknownTypesError("whitelist", []string{"m5.larg"})
+	if !strings.Contains(err.Error(), "m5.larg") || !strings.Contains(err.Error(), awsCatalog.UpdatedAt) {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
+func TestNoMatchWarning(t *testing.T) {
+	complete := &Catalog{Cloud: AWS, UpdatedAt: "2026-10-01", Complete: true}
+	partial := &Catalog{Cloud: AWS, UpdatedAt: "2026-10-01"}
+
+	if warning := complete.NoMatchWarning("filters"); strings.Contains(warning, "may still match") {
+		t.Errorf("unexpected warning %q for a complete catalog", warning)
+	}
+	if warning := partial.NoMatchWarning("filters"); !strings.Contains(warning, "may still match") {
+		t.Errorf("unexpected warning %q for a catalog that is not complete", warning)
+	}
+}
@@ -0,0 +1,247 @@
+package instance_catalog
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+// MatchAWSFilters reports whether an instance type satisfies Ocean AWS
+// instance type filters. Disk types, ENIs, network performance, root device
+// and virtualization types are not in the catalog and are not checked.
+func MatchAWSFilters(t *InstanceType, f *aws.Filters) bool {
+	if len(f.Architectures) > 0 && !containsFold(f.Architectures, t.Architecture) {
+		return false
+	}
+	if len(f.Categories) > 0 && !containsFold(f.Categories, t.Category) {
+		return false
+	}
+	if len(f.IncludeFamilies) > 0 && !matchAnyFamily(f.IncludeFamilies, t.Family) {
+		return false
+	}
+	if len(f.ExcludeFamilies) > 0 && matchAnyFamily(f.ExcludeFamilies, t.Family) {
+		return false
+	}
+	if spotinst.BoolValue(f.ExcludeMetal) && t.Metal {
+		return false
+	}
+	if len(f.Hypervisor) > 0 && t.Hypervisor != "" && !containsFold(f.Hypervisor, t.Hypervisor) {
+		return false
+	}
+	if f.IsEnaSupported != nil && t.EnaSupported != nil && *f.IsEnaSupported != *t.EnaSupported {
+		return false
+	}
+	if (f.MinVcpu != nil && t.VCPU < *f.MinVcpu) || (f.MaxVcpu != nil && t.VCPU > *f.MaxVcpu) {
+		return false
+	}
+	if (f.MinMemoryGiB != nil && t.MemoryGiB < *f.MinMemoryGiB) || (f.MaxMemoryGiB != nil && t.MemoryGiB > *f.MaxMemoryGiB) {
+		return false
+	}
+	if (f.MinGpu != nil && t.GPU < *f.MinGpu) || (f.MaxGpu != nil && t.GPU > *f.MaxGpu) {
+		return false
+	}
+	return true
+}
+
+// ResolveAWSFilters returns the catalog instance types that satisfy the
+// filters. It only fails when the filters contradict each other; callers
+// decide what an empty result means, since only a complete catalog lists
+// every instance type.
+func ResolveAWSFilters(c *Catalog, f *aws.Filters) ([]string, error) {
+	if err := checkIntRange("vcpu", f.MinVcpu, f.MaxVcpu); err != nil {
+		return nil, err
+	}
+	if err := checkFloatRange("memory_gib", f.MinMemoryGiB, f.MaxMemoryGiB); err != nil {
+		return nil, err
+	}
+	if err := checkIntRange("gpu", f.MinGpu, f.MaxGpu); err != nil {
+		return nil, err
+	}
+	if err := checkIntRange("network_performance", f.MinNetworkPerformance, f.MaxNetworkPerformance); err != nil {
+		return nil, err
+	}
+
+	return c.Filter(func(t *InstanceType) bool { return MatchAWSFilters(t, f) }), nil
+}
+
+// MatchAzureFilters reports whether a VM size satisfies Ocean AKS VM size
+// filters. Minimum NICs and data disks are not in the catalog and are not
+// checked.
+func MatchAzureFilters(t *InstanceType, f *azure_np.Filters) bool {
+	if len(f.Architectures) > 0 && !matchAzureArchitecture(f.Architectures, t.Architecture) {
+		return false
+	}
+	if len(f.Series) > 0 && !containsSeries(f.Series, t.Family) {
+		return false
+	}
+	if len(f.ExcludeSeries) > 0 && containsSeries(f.ExcludeSeries, t.Family) {
+		return false
+	}
+	if len(f.VmTypes) > 0 && !containsFold(f.VmTypes, t.Category) {
+		return false
+	}
+	if len(f.GpuTypes) > 0 && !containsFold(f.GpuTypes, t.GPUType) {
+		return false
+	}
+	if strings.EqualFold(spotinst.StringValue(f.AcceleratedNetworking), "Enabled") &&
+		t.AcceleratedNetworking != nil && !*t.AcceleratedNetworking {
+		return false
+	}
+	if strings.EqualFold(spotinst.StringValue(f.DiskPerformance), "Premium") &&
+		t.PremiumStorage != nil && !*t.PremiumStorage {
+		return false
+	}
+	if (f.MinVcpu != nil && t.VCPU < *f.MinVcpu) || (f.MaxVcpu != nil && t.VCPU > *f.MaxVcpu) {
+		return false
+	}
+	if (f.MinMemoryGiB != nil && t.MemoryGiB < *f.MinMemoryGiB) || (f.MaxMemoryGiB != nil && t.MemoryGiB > *f.MaxMemoryGiB) {
+		return false
+	}
+	gpu := float64(t.GPU)
+	if (f.MinGpu != nil && gpu < *f.MinGpu) || (f.MaxGpu != nil && gpu > *f.MaxGpu) {
+		return false
+	}
+	return true
+}
+
+// ResolveAzureFilters is the Azure counterpart of ResolveAWSFilters.
+func ResolveAzureFilters(c *Catalog, f *azure_np.Filters) ([]string, error) {
+	if err := checkIntRange("vcpu", f.MinVcpu, f.MaxVcpu); err != nil {
+		return nil, err
+	}
+	if err := checkFloatRange("memory_gib", f.MinMemoryGiB, f.MaxMemoryGiB); err != nil {
+		return nil, err
+	}
+	if err := checkFloatRange("gpu", f.MinGpu, f.MaxGpu); err != nil {
+		return nil, err
+	}
+
+	return c.Filter(func(t *InstanceType) bool { return MatchAzureFilters(t, f) }), nil
+}
+
+// MachineTypeFilters are the GKE machine type filters. Ocean GKE has no
+// filters of its own, so they follow the vocabulary of the Ocean AWS
+// instance type filters.
+type MachineTypeFilters struct {
+	Architectures   []string
+	Categories      []string
+	IncludeFamilies []string
+	ExcludeFamilies []string
+	MinVcpu         *int
+	MaxVcpu         *int
+	MinMemoryGiB    *float64
+	MaxMemoryGiB    *float64
+	MinGpu          *int
+	MaxGpu          *int
+}
+
+// MatchGCPFilters reports whether a machine type satisfies GKE machine type
+// filters.
+func MatchGCPFilters(t *InstanceType, f *MachineTypeFilters) bool {
+	if len(f.Architectures) > 0 && !containsFold(f.Architectures, t.Architecture) {
+		return false
+	}
+	if len(f.Categories) > 0 && !containsFold(f.Categories, t.Category) {
+		return false
+	}
+	if len(f.IncludeFamilies) > 0 && !matchAnyFamily(f.IncludeFamilies, t.Family) {
+		return false
+	}
+	if len(f.ExcludeFamilies) > 0 && matchAnyFamily(f.ExcludeFamilies, t.Family) {
+		return false
+	}
+	if (f.MinVcpu != nil && t.VCPU < *f.MinVcpu) || (f.MaxVcpu != nil && t.VCPU > *f.MaxVcpu) {
+		return false
+	}
+	if (f.MinMemoryGiB != nil && t.MemoryGiB < *f.MinMemoryGiB) || (f.MaxMemoryGiB != nil && t.MemoryGiB > *f.MaxMemoryGiB) {
+		return false
+	}
+	if (f.MinGpu != nil && t.GPU < *f.MinGpu) || (f.MaxGpu != nil && t.GPU > *f.MaxGpu) {
+		return false
+	}
+	return true
+}
+
+// ResolveGCPFilters is the GCP counterpart of ResolveAWSFilters.
+func ResolveGCPFilters(c *Catalog, f *MachineTypeFilters) ([]string, error) {
+	if err := checkIntRange("vcpu", f.MinVcpu, f.MaxVcpu); err != nil {
+		return nil, err
+	}
+	if err := checkFloatRange("memory_gib", f.MinMemoryGiB, f.MaxMemoryGiB); err != nil {
+		return nil, err
+	}
+	if err := checkIntRange("gpu", f.MinGpu, f.MaxGpu); err != nil {
+		return nil, err
+	}
+
+	return c.Filter(func(t *InstanceType) bool { return MatchGCPFilters(t, f) }), nil
+}
+
+func checkIntRange(name string, min, max *int) error {
+	if min != nil && max != nil && *min > *max {
+		return fmt.Errorf("min_%s (%d) is greater than max_%s (%d)", name, *min, name, *max)
+	}
+	return nil
+}
+
+func checkFloatRange(name string, min, max *float64) error {
+	if min != nil && max != nil && *min > *max {
+		return fmt.Errorf("min_%s (%g) is greater than max_%s (%g)", name, *min, name, *max)
+	}
+	return nil
+}
+
+func containsFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFamily matches a family against a pattern that may use the asterisk
+// wildcard, ignoring case, e.g. "C*" matches "c5".
+func matchFamily(pattern, family string) bool {
+	ok, err := path.Match(strings.ToLower(pattern), strings.ToLower(family))
+	return err == nil && ok
+}
+
+func matchAnyFamily(patterns []string, family string) bool {
+	for _, pattern := range patterns {
+		if matchFamily(pattern, family) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeSeries ignores case, spaces and underscores, so "DSv2", "Ds v2"
+// and "ds_v2" name the same series.
+func normalizeSeries(series string) string {
+	return strings.NewReplacer(" ", "", "_", "").Replace(strings.ToLower(series))
+}
+
+func containsSeries(list []string, series string) bool {
+	for _, v := range list {
+		if normalizeSeries(v) == normalizeSeries(series) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAzureArchitecture treats x86_64 as both intel64 and amd64.
+func matchAzureArchitecture(list []string, architecture string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, architecture) ||
+			strings.EqualFold(v, "x86_64") && (architecture == "intel64" || architecture == "amd64") {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,10 @@
 package notification_center
 
-import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+import (
+	"regexp"
+
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
 
 const (
 	Name         commons.FieldName = "name"
@@ -33,4 +37,27 @@ const (
 	Identifier                commons.FieldName = "identifier"
 	Operator                  commons.FieldName = "operator"
 	Expression                commons.FieldName = "expression"
+
+	OceanIDs           commons.FieldName = "ocean_ids"
+	ElastigroupIDs     commons.FieldName = "elastigroup_ids"
+	ManagedInstanceIDs commons.FieldName = "managed_instance_ids"
+	TagRules           commons.FieldName = "tag_rules"
+	Key                commons.FieldName = "key"
+	Value              commons.FieldName = "value"
+)
+
+// ResourceIDFields are the typed resource ID lists of compute_policy_config,
+// which are sent to the API as part of resource_ids.
+var ResourceIDFields = []commons.FieldName{OceanIDs, ElastigroupIDs, ManagedInstanceIDs}
+
+var (
+	eventTypes       = []string{"ERROR", "WARN", "INFO"}
+	filterOperators  = []string{"equals", "not_equals", "contains", "not_contains", "start_with", "end_with"}
+	tagIdentifier    = "tag"
+	defaultOperator  = "equals"
+	resourceIDFormat = map[commons.FieldName]*regexp.Regexp{
+		OceanIDs:           regexp.MustCompile(`^o-[0-9a-z]+$`),
+		ElastigroupIDs:     regexp.MustCompile(`^sig-[0-9a-z]+$`),
+		ManagedInstanceIDs: regexp.MustCompile(`^smi-[0-9a-z]+$`),
+	}
 )
@@ -2,7 +2,11 @@ package notification_center
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/notificationcenter"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -304,8 +308,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Optional: true,
 								},
 								string(EventType): {
-									Type:     schema.TypeString,
-									Optional: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(eventTypes, false),
 								},
 							},
 						},
@@ -321,6 +326,52 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 							Type: schema.TypeString,
 						},
 					},
+					string(OceanIDs): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(resourceIDFormat[OceanIDs], "expected an Ocean cluster ID (o-...)"),
+						},
+					},
+					string(ElastigroupIDs): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(resourceIDFormat[ElastigroupIDs], "expected an Elastigroup ID (sig-...)"),
+						},
+					},
+					string(ManagedInstanceIDs): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(resourceIDFormat[ManagedInstanceIDs], "expected a managed instance ID (smi-...)"),
+						},
+					},
+					string(TagRules): {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(Key): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								string(Value): {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								string(Operator): {
+									Type:         schema.TypeString,
+									Optional:     true,
+									Default:      defaultOperator,
+									ValidateFunc: validation.StringInSlice(filterOperators, false),
+								},
+							},
+						},
+					},
 					string(DynamicRules): {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -336,8 +387,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 												Optional: true,
 											},
 											string(Operator): {
-												Type:     schema.TypeString,
-												Optional: true,
+												Type:         schema.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringInSlice(filterOperators, false),
 											},
 											string(Expression): {
 												Type:     schema.TypeString,
@@ -358,7 +410,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var result []interface{} = nil
 
 			if nc != nil && nc.ComputePolicyConfig != nil {
-				result = flattenComputePolicyConfig(nc.ComputePolicyConfig)
+				var current map[string]interface{}
+				if list, ok := resourceData.Get(string(ComputePolicyConfig)).([]interface{}); ok && len(list) > 0 {
+					current, _ = list[0].(map[string]interface{})
+				}
+				result = flattenComputePolicyConfig(nc.ComputePolicyConfig, current)
 			}
 
 			if len(result) > 0 {
@@ -485,6 +541,20 @@ func expandComputePolicyConfig(data interface{}) (*notificationcenter.ComputePol
 				if err != nil {
 					return nil, err
 				}
+				// The typed resource ID lists are sent as resource IDs.
+				for _, field := range ResourceIDFields {
+					if v, ok := m[string(field)]; ok {
+						ids, err := expandNotificationList(v)
+						if err != nil {
+							return nil, err
+						}
+						for _, id := range ids {
+							if !slices.Contains(resourceIds, id) {
+								resourceIds = append(resourceIds, id)
+							}
+						}
+					}
+				}
 				if resourceIds != nil {
 					computePolicyConfig.SetResourceIds(resourceIds)
 				} else {
@@ -496,6 +566,10 @@ func expandComputePolicyConfig(data interface{}) (*notificationcenter.ComputePol
 				if err != nil {
 					return nil, err
 				}
+				// Every tag rule is sent as a dynamic rule of its own.
+				if v, ok := m[string(TagRules)]; ok {
+					dynamicRules = append(dynamicRules, expandTagRules(v)...)
+				}
 				if dynamicRules != nil {
 					computePolicyConfig.SetDynamicRules(dynamicRules)
 				} else {
@@ -608,7 +682,11 @@ func flattenSubscriptions(subscriptions []*notificationcenter.Subscriptions) []i
 	return result
 }
 
-func flattenComputePolicyConfig(computePolicy *notificationcenter.ComputePolicyConfig) []interface{} {
+// flattenComputePolicyConfig flattens the compute policy config. Resource
+// IDs and dynamic rules that are in the typed resource ID lists or the tag
+// rules of current (the compute_policy_config in state) are flattened there,
+// the others into resource_ids and dynamic_rules.
+func flattenComputePolicyConfig(computePolicy *notificationcenter.ComputePolicyConfig, current map[string]interface{}) []interface{} {
 	var out []interface{}
 	if computePolicy != nil {
 		result := make(map[string]interface{})
@@ -616,11 +694,17 @@ func flattenComputePolicyConfig(computePolicy *notificationcenter.ComputePolicyC
 			result[string(Events)] = flattenEvents(computePolicy.Events)
 		}
 		if computePolicy.DynamicRules != nil {
-			result[string(DynamicRules)] = flattenDynamicRules(computePolicy.DynamicRules)
+			dynamicRules, tagRules := splitTagRules(computePolicy.DynamicRules, current)
+			result[string(DynamicRules)] = flattenDynamicRules(dynamicRules)
+			if len(tagRules) > 0 {
+				result[string(TagRules)] = tagRules
+			}
 		}
 		result[string(ShouldIncludeAllResources)] = spotinst.BoolValue(computePolicy.ShouldIncludeAllResources)
 		if len(computePolicy.ResourceIds) > 0 {
-			result[string(ResourceIds)] = computePolicy.ResourceIds
+			for field, ids := range splitResourceIDs(computePolicy.ResourceIds, current) {
+				result[string(field)] = ids
+			}
 		}
 
 		if len(result) > 0 {
@@ -630,6 +714,118 @@ func flattenComputePolicyConfig(computePolicy *notificationcenter.ComputePolicyC
 	return out
 }
 
+func splitResourceIDs(resourceIDs []string, current map[string]interface{}) map[commons.FieldName][]string {
+	// An ID listed in several lists is flattened into each of them.
+	listed := make(map[string][]commons.FieldName)
+	for _, field := range append([]commons.FieldName{ResourceIds}, ResourceIDFields...) {
+		if list, ok := current[string(field)].([]interface{}); ok {
+			for _, id := range list {
+				if id, ok := id.(string); ok && !slices.Contains(listed[id], field) {
+					listed[id] = append(listed[id], field)
+				}
+			}
+		}
+	}
+
+	result := make(map[commons.FieldName][]string)
+	for _, id := range resourceIDs {
+		fields, ok := listed[id]
+		if !ok {
+			fields = []commons.FieldName{ResourceIds}
+		}
+		for _, field := range fields {
+			result[field] = append(result[field], id)
+		}
+	}
+	return result
+}
+
+func splitTagRules(dynamicRules []*notificationcenter.DynamicRules, current map[string]interface{}) ([]*notificationcenter.DynamicRules, []interface{}) {
+	var stateTagRules []interface{}
+	if list, ok := current[string(TagRules)].([]interface{}); ok {
+		stateTagRules = list
+	}
+
+	var rules []*notificationcenter.DynamicRules
+	var tagRules []interface{}
+	for _, rule := range dynamicRules {
+		var tagRule interface{}
+		if len(rule.FilterConditions) == 1 {
+			for _, stateRule := range stateTagRules {
+				if sameFilterCondition(rule.FilterConditions[0], expandTagRule(stateRule)) {
+					tagRule = stateRule
+					break
+				}
+			}
+		}
+
+		if tagRule != nil {
+			tagRules = append(tagRules, tagRule)
+		} else {
+			rules = append(rules, rule)
+		}
+	}
+	return rules, tagRules
+}
+
+func sameFilterCondition(a, b *notificationcenter.FilterConditions) bool {
+	return spotinst.StringValue(a.Identifier) == spotinst.StringValue(b.Identifier) &&
+		spotinst.StringValue(a.Operator) == spotinst.StringValue(b.Operator) &&
+		spotinst.StringValue(a.Expression) == spotinst.StringValue(b.Expression)
+}
+
+func expandTagRules(data interface{}) []*notificationcenter.DynamicRules {
+	list, _ := data.([]interface{})
+	rules := make([]*notificationcenter.DynamicRules, 0, len(list))
+	for _, item := range list {
+		rule := &notificationcenter.DynamicRules{}
+		rule.SetFilterConditions([]*notificationcenter.FilterConditions{expandTagRule(item)})
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// expandTagRule expands a tag rule into a filter condition on the tag
+// identifier. The expression follows the documented dynamic_rules form, e.g.
+// "resourceId EQUALS 'sig-123456789'", with the tag written as key:value
+// (e.g. "tag EQUALS 'team:data'"). TestAccSpotinstNotificationCenter_TagRules
+// checks that the API stores it as sent.
+func expandTagRule(data interface{}) *notificationcenter.FilterConditions {
+	m, _ := data.(map[string]interface{})
+	key, _ := m[string(Key)].(string)
+	value, _ := m[string(Value)].(string)
+	operator, _ := m[string(Operator)].(string)
+	if operator == "" {
+		operator = defaultOperator
+	}
+
+	condition := &notificationcenter.FilterConditions{}
+	condition.SetIdentifier(spotinst.String(tagIdentifier))
+	condition.SetOperator(spotinst.String(operator))
+	condition.SetExpression(spotinst.String(fmt.Sprintf("%s %s '%s:%s'", tagIdentifier, strings.ToUpper(operator), key, value)))
+	return condition
+}
+
+// ReferencedResourceIDs returns the IDs of the typed resource ID lists of
+// compute_policy_config, when they are known.
+func ReferencedResourceIDs(diff *schema.ResourceDiff) map[commons.FieldName][]string {
+	result := make(map[commons.FieldName][]string)
+	prefix := string(ComputePolicyConfig) + ".0."
+	for _, field := range ResourceIDFields {
+		if !diff.NewValueKnown(prefix + string(field)) {
+			continue
+		}
+		if list, ok := diff.Get(prefix + string(field)).([]interface{}); ok {
+			for _, id := range list {
+				if id, ok := id.(string); ok && id != "" {
+					result[field] = append(result[field], id)
+				}
+			}
+		}
+	}
+	return result
+}
+
 func flattenEvents(events []*notificationcenter.Events) []interface{} {
 	result := make([]interface{}, 0, len(events))
 	for _, event := range events {
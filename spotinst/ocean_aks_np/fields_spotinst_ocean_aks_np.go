@@ -348,7 +348,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(TimeWindows): {
 									Type:     schema.TypeList,
 									Optional: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: commons.ValidateTimeWindow,
+									},
 								},
 							},
 						},
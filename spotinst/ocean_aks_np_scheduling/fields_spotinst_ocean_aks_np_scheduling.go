@@ -34,7 +34,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(TimeWindows): {
 									Type:     schema.TypeList,
 									Optional: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: commons.ValidateTimeWindow,
+									},
 								},
 							},
 						},
@@ -53,7 +56,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(TimeWindows): {
 									Type:     schema.TypeList,
 									Optional: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: commons.ValidateTimeWindow,
+									},
 								},
 							},
 						},
@@ -74,8 +80,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(CronExpression): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: commons.ValidateCronExpression,
 								},
 
 								string(Parameters): {
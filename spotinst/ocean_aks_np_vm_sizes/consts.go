@@ -20,3 +20,7 @@ const (
 	VmTypes               commons.FieldName = "vm_types"
 	GpuTypes              commons.FieldName = "gpu_types"
 )
+
+const (
+	ResolvedVmSizes commons.FieldName = "resolved_vm_sizes"
+)
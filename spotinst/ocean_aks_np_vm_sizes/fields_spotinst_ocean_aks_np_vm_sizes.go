@@ -2,11 +2,13 @@ package ocean_aks_np_vm_sizes
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -17,97 +19,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Type:     schema.TypeList,
 			Optional: true,
 			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					string(MinVcpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MaxVcpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinMemoryGiB): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MaxMemoryGiB): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(Series): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(Architectures): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(ExcludeSeries): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(AcceleratedNetworking): {
-						Type:     schema.TypeString,
-						Optional: true,
-					},
-
-					string(DiskPerformance): {
-						Type:     schema.TypeString,
-						Optional: true,
-					},
-
-					string(MinGpu): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MaxGpu): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinNICs): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinDisk): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(VmTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(GpuTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-				},
-			},
+			Elem:     FiltersResource(),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AKSNPClusterWrapper)
@@ -159,6 +71,190 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[ResolvedVmSizes] = commons.NewGenericField(
+		commons.OceanAKSNPVmSizes,
+		ResolvedVmSizes,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.AKSNPClusterWrapper)
+			cluster := clusterWrapper.GetNPCluster()
+			var result []string = nil
+
+			if cluster != nil && cluster.VirtualNodeGroupTemplate != nil && cluster.VirtualNodeGroupTemplate.VmSizes != nil &&
+				cluster.VirtualNodeGroupTemplate.VmSizes.Filters != nil {
+				catalog, err := instance_catalog.Load(instance_catalog.Azure)
+				if err != nil {
+					return err
+				}
+				if catalog.Complete {
+					filters := cluster.VirtualNodeGroupTemplate.VmSizes.Filters
+					result = catalog.Filter(func(t *instance_catalog.InstanceType) bool {
+						return instance_catalog.MatchAzureFilters(t, filters)
+					})
+				}
+			}
+			if err := resourceData.Set(string(ResolvedVmSizes), result); err != nil {
+				return fmt.Errorf(commons.FailureFieldReadPattern, string(ResolvedVmSizes), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+}
+
+// CustomizeDiff checks the VM size filters against the instance type catalog
+// and plans resolved_vm_sizes. Contradicting filters always fail at plan time.
+// Filters that match no VM size only fail against a complete catalog, and the
+// resolved VM sizes are only planned from one; otherwise they are left empty.
+func CustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown(string(Filters)) {
+		return nil
+	}
+
+	catalog, err := instance_catalog.Load(instance_catalog.Azure)
+	if err != nil {
+		return err
+	}
+
+	var resolved []string
+	if v, ok := diff.GetOk(string(Filters)); ok {
+		filters, err := expandFilters(v, false)
+		if err != nil {
+			return err
+		}
+		if resolved, err = instance_catalog.ResolveAzureFilters(catalog, filters); err != nil {
+			return fmt.Errorf("%s: %v", Filters, err)
+		}
+		if !catalog.Complete {
+			resolved = nil
+		} else if len(resolved) == 0 {
+			return catalog.NoMatchError(string(Filters))
+		}
+	}
+
+	var current []string
+	for _, v := range diff.Get(string(ResolvedVmSizes)).([]interface{}) {
+		if name, ok := v.(string); ok {
+			current = append(current, name)
+		}
+	}
+	if strings.Join(current, ",") == strings.Join(resolved, ",") {
+		return nil
+	}
+	return diff.SetNew(string(ResolvedVmSizes), resolved)
+}
+
+// FiltersResource returns the schema of the VM size filters, shared with the
+// spotinst_ocean_aks_vm_sizes data source.
+func FiltersResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			string(MinVcpu): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MaxVcpu): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinMemoryGiB): {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MaxMemoryGiB): {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(Series): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(Architectures): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(ExcludeSeries): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(AcceleratedNetworking): {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			string(DiskPerformance): {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			string(MinGpu): {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MaxGpu): {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinNICs): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinDisk): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(VmTypes): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(GpuTypes): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// ExpandFilters converts filters in the FiltersResource schema to the API
+// representation.
+func ExpandFilters(data interface{}) (*azure_np.Filters, error) {
+	return expandFilters(data, false)
 }
 
 func expandFilters(data interface{}, nullify bool) (*azure_np.Filters, error) {
@@ -0,0 +1,11 @@
+package ocean_aws_cluster_scheduled_task
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	OceanID        commons.FieldName = "ocean_id"
+	TaskType       commons.FieldName = "task_type"
+	CronExpression commons.FieldName = "cron_expression"
+	IsEnabled      commons.FieldName = "is_enabled"
+	Parameters     commons.FieldName = "parameters"
+)
@@ -0,0 +1,146 @@
+package ocean_aws_cluster_scheduled_task
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_scheduling"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[OceanID] = commons.NewGenericField(
+		commons.OceanAWSClusterScheduledTask,
+		OceanID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[TaskType] = commons.NewGenericField(
+		commons.OceanAWSClusterScheduledTask,
+		TaskType,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(TaskType), spotinst.StringValue(task.Type)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TaskType), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(TaskType)); ok {
+				task.SetType(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[CronExpression] = commons.NewGenericField(
+		commons.OceanAWSClusterScheduledTask,
+		CronExpression,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commons.ValidateCronExpression,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(CronExpression), spotinst.StringValue(task.CronExpression)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(CronExpression), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(CronExpression)); ok {
+				task.SetCronExpression(spotinst.String(v.(string)))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[IsEnabled] = commons.NewGenericField(
+		commons.OceanAWSClusterScheduledTask,
+		IsEnabled,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			if err := resourceData.Set(string(IsEnabled), spotinst.BoolValue(task.IsEnabled)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(IsEnabled), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			task.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			task.SetIsEnabled(spotinst.Bool(resourceData.Get(string(IsEnabled)).(bool)))
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[Parameters] = commons.NewGenericField(
+		commons.OceanAWSClusterScheduledTask,
+		Parameters,
+		ocean_aws_scheduling.ParametersSchema(),
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			var result []interface{} = nil
+			if task.Parameter != nil {
+				result = ocean_aws_scheduling.FlattenParameters(task.Parameter)
+			}
+			if err := resourceData.Set(string(Parameters), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Parameters), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			if v, ok := resourceData.GetOk(string(Parameters)); ok {
+				parameter, err := ocean_aws_scheduling.ExpandParameters(v)
+				if err != nil {
+					return err
+				}
+				task.SetParameter(parameter)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			task := resourceObject.(*commons.OceanAWSScheduledTaskWrapper).GetTask()
+			parameter, err := ocean_aws_scheduling.ExpandParameters(resourceData.Get(string(Parameters)))
+			if err != nil {
+				return err
+			}
+			task.SetParameter(parameter)
+			return nil
+		},
+		nil,
+	)
+}
@@ -6,3 +6,17 @@ const (
 	ExtendedResourceName commons.FieldName = "name"
 	Mapping              commons.FieldName = "resource_mapping"
 )
+
+// Attributes of the spotinst_ocean_aws_extended_resource_definitions data
+// source.
+const (
+	NameRegex                   commons.FieldName = "name_regex"
+	IDs                         commons.FieldName = "ids"
+	Names                       commons.FieldName = "names"
+	ExtendedResourceDefinitions commons.FieldName = "extended_resource_definitions"
+	ID                          commons.FieldName = "id"
+)
+
+// defaultMapping is the resource_mapping key that applies to every instance
+// type without a mapping of its own.
+const defaultMapping = "*"
@@ -2,8 +2,11 @@ package ocean_aws_extended_resource_definition
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
@@ -83,3 +86,72 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 	)
 
 }
+
+// ValidateReferences returns an error naming every ID in ids that is not the
+// ID of one of the defined extended resource definitions.
+func ValidateReferences(field string, ids []string, defined []*aws.ExtendedResourceDefinition) error {
+	byID := make(map[string]*aws.ExtendedResourceDefinition, len(defined))
+	byName := make(map[string]*aws.ExtendedResourceDefinition, len(defined))
+	for _, definition := range defined {
+		byID[spotinst.StringValue(definition.ID)] = definition
+		byName[spotinst.StringValue(definition.Name)] = definition
+	}
+
+	var errs []string
+	for _, id := range ids {
+		if _, ok := byID[id]; ok {
+			continue
+		}
+		if definition, ok := byName[id]; ok {
+			errs = append(errs, fmt.Sprintf("%q is the name of extended resource definition %q, reference it by ID",
+				id, spotinst.StringValue(definition.ID)))
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("extended resource definition %q does not exist", id))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %s", field, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// ValidateMappings returns an error naming, for every extended resource
+// definition in ids, the instance types it has no resource_mapping for.
+func ValidateMappings(field string, ids []string, defined []*aws.ExtendedResourceDefinition, instanceTypes []string) error {
+	byID := make(map[string]*aws.ExtendedResourceDefinition, len(defined))
+	for _, definition := range defined {
+		byID[spotinst.StringValue(definition.ID)] = definition
+	}
+
+	var errs []string
+	for _, id := range ids {
+		definition, ok := byID[id]
+		if !ok {
+			continue
+		}
+		if unmapped := unmappedInstanceTypes(definition, instanceTypes); len(unmapped) > 0 {
+			errs = append(errs, fmt.Sprintf("extended resource definition %q (%s) has no mapping for %s",
+				id, spotinst.StringValue(definition.Name), strings.Join(unmapped, ", ")))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %s", field, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// unmappedInstanceTypes returns the sorted instance types definition has no
+// mapping for. The "*" mapping applies to every instance type.
+func unmappedInstanceTypes(definition *aws.ExtendedResourceDefinition, instanceTypes []string) []string {
+	if _, ok := definition.Mapping[defaultMapping]; ok {
+		return nil
+	}
+	var unmapped []string
+	for _, instanceType := range instanceTypes {
+		if _, ok := definition.Mapping[instanceType]; !ok {
+			unmapped = append(unmapped, instanceType)
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
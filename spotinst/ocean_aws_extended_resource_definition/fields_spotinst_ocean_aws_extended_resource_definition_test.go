@@ -0,0 +1,138 @@
+package ocean_aws_extended_resource_definition
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+)
+
+func testExtendedResourceDefinitions() []*aws.ExtendedResourceDefinition {
+	return []*aws.ExtendedResourceDefinition{
+		{
+			ID:      spotinst.String("erd-1"),
+			Name:    spotinst.String("example.com/gpu"),
+			Mapping: map[string]interface{}{"g4dn.xlarge": "1", "g4dn.2xlarge": "2"},
+		},
+		{
+			ID:      spotinst.String("erd-2"),
+			Name:    spotinst.String("example.com/license"),
+			Mapping: map[string]interface{}{"*": "1"},
+		},
+		{
+			ID:   spotinst.String("erd-3"),
+			Name: spotinst.String("example.com/empty"),
+		},
+	}
+}
+
+func TestValidateReferences(t *testing.T) {
+	cases := []struct {
+		name string
+		ids  []string
+		errs []string
+	}{
+		{
+			name: "no references",
+		},
+		{
+			name: "known IDs",
+			ids:  []string{"erd-1", "erd-2"},
+		},
+		{
+			name: "name instead of ID",
+			ids:  []string{"erd-1", "example.com/gpu"},
+			errs: []string{`"example.com/gpu" is the name of extended resource definition "erd-1", reference it by ID`},
+		},
+		{
+			name: "unknown ID",
+			ids:  []string{"erd-9"},
+			errs: []string{`extended resource definition "erd-9" does not exist`},
+		},
+		{
+			name: "several errors",
+			ids:  []string{"erd-9", "example.com/license"},
+			errs: []string{
+				`extended resource definition "erd-9" does not exist`,
+				`"example.com/license" is the name of extended resource definition "erd-2"`,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateReferences("extended_resource_definitions", c.ids, testExtendedResourceDefinitions())
+			if len(c.errs) == 0 {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error, got nil")
+			}
+			if !strings.HasPrefix(err.Error(), "extended_resource_definitions: ") {
+				t.Errorf("expected the field in %q", err)
+			}
+			for _, e := range c.errs {
+				if !strings.Contains(err.Error(), e) {
+					t.Errorf("expected %q in %q", e, err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMappings(t *testing.T) {
+	cases := []struct {
+		name          string
+		ids           []string
+		instanceTypes []string
+		err           string
+	}{
+		{
+			name:          "every instance type is mapped",
+			ids:           []string{"erd-1"},
+			instanceTypes: []string{"g4dn.xlarge", "g4dn.2xlarge"},
+		},
+		{
+			name:          "default mapping",
+			ids:           []string{"erd-2"},
+			instanceTypes: []string{"m5.large", "c5.large"},
+		},
+		{
+			name:          "unknown definitions are left to ValidateReferences",
+			ids:           []string{"erd-9"},
+			instanceTypes: []string{"m5.large"},
+		},
+		{
+			name:          "unmapped instance types are sorted",
+			ids:           []string{"erd-1", "erd-2"},
+			instanceTypes: []string{"m5.large", "g4dn.xlarge", "c5.large"},
+			err:           `instance_types: extended resource definition "erd-1" (example.com/gpu) has no mapping for c5.large, m5.large`,
+		},
+		{
+			name:          "definition without mappings",
+			ids:           []string{"erd-1", "erd-3"},
+			instanceTypes: []string{"m5.large"},
+			err: `instance_types: extended resource definition "erd-1" (example.com/gpu) has no mapping for m5.large; ` +
+				`extended resource definition "erd-3" (example.com/empty) has no mapping for m5.large`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := ValidateMappings("instance_types", c.ids, testExtendedResourceDefinitions(), c.instanceTypes)
+			if c.err == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != c.err {
+				t.Errorf("expected %q, got %v", c.err, err)
+			}
+		})
+	}
+}
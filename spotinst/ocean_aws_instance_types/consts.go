@@ -5,6 +5,8 @@ import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 const (
 	Whitelist commons.FieldName = "whitelist"
 	Blacklist commons.FieldName = "blacklist"
+
+	ResolvedInstanceTypes commons.FieldName = "resolved_instance_types"
 )
 
 const (
@@ -2,11 +2,13 @@ package ocean_aws_instance_types
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -123,123 +125,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Optional:      true,
 			MaxItems:      1,
 			ConflictsWith: []string{string(Blacklist), string(Whitelist)},
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-
-					string(Architectures): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(Categories): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(DiskTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(ExcludeFamilies): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(ExcludeMetal): {
-						Type:     schema.TypeBool,
-						Optional: true,
-						Default:  false,
-					},
-
-					string(Hypervisor): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(IncludeFamilies): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(IsEnaSupported): {
-						Type:     schema.TypeString,
-						Optional: true,
-					},
-
-					string(MaxGpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MaxMemoryGiB): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MaxNetworkPerformance): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MaxVcpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinEnis): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinGpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinMemoryGiB): {
-						Type:     schema.TypeFloat,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinNetworkPerformance): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(MinVcpu): {
-						Type:     schema.TypeInt,
-						Optional: true,
-						Default:  -1,
-					},
-
-					string(RootDeviceTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-
-					string(VirtualizationTypes): {
-						Type:     schema.TypeSet,
-						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
-					},
-				},
-			},
+			Elem:          FiltersResource(),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
@@ -291,6 +177,238 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[ResolvedInstanceTypes] = commons.NewGenericField(
+		commons.OceanAWSInstanceTypes,
+		ResolvedInstanceTypes,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			clusterWrapper := resourceObject.(*commons.AWSClusterWrapper)
+			cluster := clusterWrapper.GetCluster()
+			var result []string = nil
+			if cluster.Compute != nil && cluster.Compute.InstanceTypes != nil &&
+				cluster.Compute.InstanceTypes.Filters != nil {
+				catalog, err := instance_catalog.Load(instance_catalog.AWS)
+				if err != nil {
+					return err
+				}
+				if catalog.Complete {
+					filters := cluster.Compute.InstanceTypes.Filters
+					result = catalog.Filter(func(t *instance_catalog.InstanceType) bool {
+						return instance_catalog.MatchAWSFilters(t, filters)
+					})
+				}
+			}
+			if err := resourceData.Set(string(ResolvedInstanceTypes), result); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(ResolvedInstanceTypes), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+}
+
+// CustomizeDiff checks the instance type settings against the instance type
+// catalog and plans resolved_instance_types. Contradicting filters always fail
+// at plan time. Unknown names, a blacklist that excludes everything and filters
+// that match nothing only fail against a complete catalog, and the resolved
+// instance types are only planned from one; otherwise they are left empty.
+func CustomizeDiff(diff *schema.ResourceDiff) error {
+	catalog, err := instance_catalog.Load(instance_catalog.AWS)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range []commons.FieldName{Whitelist, Blacklist} {
+		if !diff.NewValueKnown(string(field)) {
+			continue
+		}
+		names, err := expandInstanceTypeList(diff.Get(string(field)))
+		if err != nil {
+			return err
+		}
+		if unknown := catalog.UnknownTypes(names); len(unknown) > 0 {
+			return catalog.UnknownTypesError(string(field), unknown)
+		}
+		if catalog.Complete && field == Blacklist && len(names) > 0 && len(catalog.Filter(func(t *instance_catalog.InstanceType) bool {
+			return !contains(names, t.Name)
+		})) == 0 {
+			return fmt.Errorf("%s: every instance type in the %s instance type catalog is excluded", field, catalog.Cloud)
+		}
+	}
+
+	if !diff.NewValueKnown(string(Filters)) {
+		return nil
+	}
+
+	var resolved []string
+	if v, ok := diff.GetOk(string(Filters)); ok {
+		filters, err := expandFilters(v, false)
+		if err != nil {
+			return err
+		}
+		if resolved, err = instance_catalog.ResolveAWSFilters(catalog, filters); err != nil {
+			return fmt.Errorf("%s: %v", Filters, err)
+		}
+		if !catalog.Complete {
+			resolved = nil
+		} else if len(resolved) == 0 {
+			return catalog.NoMatchError(string(Filters))
+		}
+	}
+
+	current, _ := expandInstanceTypeList(diff.Get(string(ResolvedInstanceTypes)))
+	if strings.Join(current, ",") == strings.Join(resolved, ",") {
+		return nil
+	}
+	return diff.SetNew(string(ResolvedInstanceTypes), resolved)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// FiltersResource returns the schema of the instance type filters, shared with
+// the spotinst_ocean_aws_instance_types data source.
+func FiltersResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+
+			string(Architectures): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(Categories): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(DiskTypes): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(ExcludeFamilies): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(ExcludeMetal): {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			string(Hypervisor): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(IncludeFamilies): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(IsEnaSupported): {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			string(MaxGpu): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MaxMemoryGiB): {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MaxNetworkPerformance): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MaxVcpu): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinEnis): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinGpu): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinMemoryGiB): {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinNetworkPerformance): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(MinVcpu): {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  -1,
+			},
+
+			string(RootDeviceTypes): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			string(VirtualizationTypes): {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// ExpandFilters converts filters in the FiltersResource schema to the API
+// representation.
+func ExpandFilters(data interface{}) (*aws.Filters, error) {
+	return expandFilters(data, false)
 }
 
 func expandFilters(data interface{}, nullify bool) (*aws.Filters, error) {
@@ -6,6 +6,7 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -613,8 +614,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(HTTPTokens): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"optional", "required"}, false),
 					},
 
 					string(HTTPPutResponseHopLimit): {
@@ -725,9 +727,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(VolumeType): {
-									Type:     schema.TypeString,
-									Optional: true,
-									Computed: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									Computed:     true,
+									ValidateFunc: validation.StringInSlice(commons.AWSVolumeTypes, true),
 								},
 
 								string(DynamicVolumeSize): {
@@ -126,6 +126,10 @@ const (
 	DeleteNodes   commons.FieldName = "delete_nodes"
 )
 
+const (
+	AllowUnmappedExtendedResources commons.FieldName = "allow_unmapped_extended_resources"
+)
+
 const (
 	IsEnabled      commons.FieldName = "is_enabled"
 	CronExpression commons.FieldName = "cron_expression"
@@ -532,8 +532,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(VolumeType): {
-									Type:     schema.TypeString,
-									Optional: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(commons.AWSVolumeTypes, true),
 								},
 
 								string(DynamicVolumeSize): {
@@ -1100,8 +1101,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.OceanAWSLaunchSpec,
 		SubnetIDs,
 		&schema.Schema{
-			Type:     schema.TypeList,
-			Elem:     &schema.Schema{Type: schema.TypeString},
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commons.ValidateSubnetID,
+			},
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -1324,9 +1328,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(AvailabilityVsCost): {
-									Type:     schema.TypeString,
-									Optional: true,
-									Default:  "balanced",
+									Type:         schema.TypeString,
+									Optional:     true,
+									Default:      "balanced",
+									ValidateFunc: validation.StringInSlice(commons.OceanOrientations, false),
 								},
 							},
 						},
@@ -1544,6 +1549,16 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil, nil, nil, nil,
 	)
 
+	fieldsMap[AllowUnmappedExtendedResources] = commons.NewGenericField(
+		commons.OceanAWSLaunchSpec,
+		AllowUnmappedExtendedResources,
+		&schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		nil, nil, nil, nil,
+	)
+
 	fieldsMap[SchedulingTask] = commons.NewGenericField(
 		commons.OceanAWSLaunchSpec,
 		SchedulingTask,
@@ -1558,8 +1573,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 					string(TaskType): {
 						Type:     schema.TypeString,
@@ -1660,8 +1676,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								string(CronExpression): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: commons.ValidateCronExpression,
 								},
 								string(Duration): {
 									Type:     schema.TypeString,
@@ -1738,8 +1755,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Optional: true,
 					},
 					string(TimeWindows): {
-						Type:     schema.TypeList,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Type: schema.TypeList,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: commons.ValidateTimeWindow,
+						},
 						Required: true,
 					},
 				},
@@ -1802,8 +1822,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(HTTPTokens): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"optional", "required"}, false),
 					},
 
 					string(HTTPPutResponseHopLimit): {
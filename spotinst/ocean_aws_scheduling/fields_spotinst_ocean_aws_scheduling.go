@@ -36,94 +36,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(CronExpression): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: commons.ValidateCronExpression,
 								},
 
-								string(Parameters): {
-									Type:     schema.TypeList,
-									Optional: true,
-									MaxItems: 1,
-									Elem: &schema.Resource{
-										Schema: map[string]*schema.Schema{
-											string(AmiAutoUpdate): {
-												Type:     schema.TypeList,
-												Optional: true,
-												MaxItems: 1,
-												Elem: &schema.Resource{
-													Schema: map[string]*schema.Schema{
-														string(ApplyRoll): {
-															Type:     schema.TypeBool,
-															Optional: true,
-														},
-														string(AmiAutoUpdateClusterRoll): {
-															Type:     schema.TypeList,
-															Optional: true,
-															MaxItems: 1,
-															Elem: &schema.Resource{
-																Schema: map[string]*schema.Schema{
-																	string(BatchMinHealthyPercentage): {
-																		Type:     schema.TypeInt,
-																		Optional: true,
-																		Default:  -1,
-																	},
-																	string(BatchSizePercentage): {
-																		Type:     schema.TypeInt,
-																		Optional: true,
-																		Default:  -1,
-																	},
-																	string(Comment): {
-																		Type:     schema.TypeString,
-																		Optional: true,
-																	},
-																	string(RespectPdb): {
-																		Type:     schema.TypeBool,
-																		Optional: true,
-																	},
-																},
-															},
-														},
-														string(MinorVersion): {
-															Type:     schema.TypeBool,
-															Optional: true,
-														},
-														string(Patch): {
-															Type:     schema.TypeBool,
-															Optional: true,
-														},
-													},
-												},
-											},
-											string(ParametersClusterRoll): {
-												Type:     schema.TypeList,
-												Optional: true,
-												MaxItems: 1,
-												Elem: &schema.Resource{
-													Schema: map[string]*schema.Schema{
-														string(BatchMinHealthyPercentage): {
-															Type:     schema.TypeInt,
-															Optional: true,
-															Default:  -1,
-														},
-														string(BatchSizePercentage): {
-															Type:     schema.TypeInt,
-															Optional: true,
-															Default:  -1,
-														},
-														string(Comment): {
-															Type:     schema.TypeString,
-															Optional: true,
-														},
-														string(RespectPdb): {
-															Type:     schema.TypeBool,
-															Optional: true,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
+								string(Parameters): ParametersSchema(),
 							},
 						},
 					},
@@ -141,7 +59,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(TimeWindows): {
 									Type:     schema.TypeList,
 									Required: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: commons.ValidateTimeWindow,
+									},
 								},
 							},
 						},
@@ -162,8 +83,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											string(CronExpression): {
-												Type:     schema.TypeString,
-												Required: true,
+												Type:         schema.TypeString,
+												Required:     true,
+												ValidateFunc: commons.ValidateCronExpression,
 											},
 											string(Duration): {
 												Type:     schema.TypeString,
@@ -255,6 +177,95 @@ func flattenScheduledTasks(scheduling *aws.Scheduling) []interface{} {
 	return out
 }
 
+// ParametersSchema returns the schema of a scheduled task's parameters block.
+// It is shared with the standalone spotinst_ocean_aws_scheduled_task resource.
+func ParametersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				string(AmiAutoUpdate): {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							string(ApplyRoll): {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							string(AmiAutoUpdateClusterRoll): {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										string(BatchMinHealthyPercentage): {
+											Type:     schema.TypeInt,
+											Optional: true,
+											Default:  -1,
+										},
+										string(BatchSizePercentage): {
+											Type:     schema.TypeInt,
+											Optional: true,
+											Default:  -1,
+										},
+										string(Comment): {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										string(RespectPdb): {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+									},
+								},
+							},
+							string(MinorVersion): {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+							string(Patch): {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+				string(ParametersClusterRoll): {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							string(BatchMinHealthyPercentage): {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Default:  -1,
+							},
+							string(BatchSizePercentage): {
+								Type:     schema.TypeInt,
+								Optional: true,
+								Default:  -1,
+							},
+							string(Comment): {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							string(RespectPdb): {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func flattenShutdownHours(shutdownHours *aws.ShutdownHours) []interface{} {
 	result := make(map[string]interface{})
 	result[string(ShutdownHoursIsEnabled)] = spotinst.BoolValue(shutdownHours.IsEnabled)
@@ -275,7 +286,7 @@ func flattenTasks(tasks []*aws.Task) []interface{} {
 		m[string(TaskType)] = spotinst.StringValue(task.Type)
 		m[string(CronExpression)] = spotinst.StringValue(task.CronExpression)
 		if task.Parameter != nil {
-			m[string(Parameters)] = flattenParameters(task.Parameter)
+			m[string(Parameters)] = FlattenParameters(task.Parameter)
 		}
 		result = append(result, m)
 	}
@@ -283,7 +294,7 @@ func flattenTasks(tasks []*aws.Task) []interface{} {
 	return result
 }
 
-func flattenParameters(parameters *aws.Parameter) []interface{} {
+func FlattenParameters(parameters *aws.Parameter) []interface{} {
 	result := make(map[string]interface{})
 
 	if parameters.AmiAutoUpdate != nil {
@@ -422,7 +433,7 @@ func expandShutdownHours(data interface{}) (*aws.ShutdownHours, error) {
 	return nil, nil
 }
 
-func expandParameters(data interface{}) (*aws.Parameter, error) {
+func ExpandParameters(data interface{}) (*aws.Parameter, error) {
 	if list := data.([]interface{}); list != nil && len(list) > 0 && list[0] != nil {
 		parameter := &aws.Parameter{}
 		m := list[0].(map[string]interface{})
@@ -597,7 +608,7 @@ func expandtasks(data interface{}) ([]*aws.Task, error) {
 			}
 
 			if v, ok := m[string(Parameters)]; ok {
-				parameters, err := expandParameters(v)
+				parameters, err := ExpandParameters(v)
 				if err != nil {
 					return nil, err
 				}
@@ -346,9 +346,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(AvailabilityVsCost): {
-						Type:     schema.TypeString,
-						Optional: true,
-						Default:  "balanced",
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "balanced",
+						ValidateFunc: validation.StringInSlice(commons.OceanOrientations, false),
 					},
 				},
 			},
@@ -235,8 +235,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.OceanECS,
 		SubnetIDs,
 		&schema.Schema{
-			Type:     schema.TypeList,
-			Elem:     &schema.Schema{Type: schema.TypeString},
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commons.ValidateSubnetID,
+			},
 			Required: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ const (
 	CpuPerUnit                       commons.FieldName = "cpu_per_unit"
 	MemoryPerUnit                    commons.FieldName = "memory_per_unit"
 	NumOfUnits                       commons.FieldName = "num_of_units"
+	TaskDefinition                   commons.FieldName = "task_definition"
 	ResourceLimits                   commons.FieldName = "resource_limits"
 	MaxVCpu                          commons.FieldName = "max_vcpu"
 	MaxMemoryGib                     commons.FieldName = "max_memory_gib"
@@ -59,6 +59,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Type:     schema.TypeInt,
 									Optional: true,
 								},
+
+								string(TaskDefinition): {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: commons.ValidateECSTaskDefinition,
+								},
 							},
 						},
 					},
@@ -116,7 +122,8 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var result []interface{} = nil
 
 			if cluster != nil && cluster.AutoScaler != nil {
-				result = flattenAutoscaler(cluster.AutoScaler)
+				taskDefinition := resourceData.Get(fmt.Sprintf("%s.0.%s.0.%s", Autoscaler, Headroom, TaskDefinition)).(string)
+				result = flattenAutoscaler(cluster.AutoScaler, taskDefinition)
 			}
 			if len(result) > 0 {
 				if err := resourceData.Set(string(Autoscaler), result); err != nil {
@@ -232,12 +239,21 @@ func expandOceanAWSAutoScalerHeadroom(data interface{}) (*aws.ECSAutoScalerHeadr
 		if list != nil && list[0] != nil {
 			m := list[0].(map[string]interface{})
 
-			if v, ok := m[string(CpuPerUnit)].(int); ok && v >= 0 {
-				headroom.SetCPUPerUnit(spotinst.Int(v))
-			}
+			if v, ok := m[string(TaskDefinition)].(string); ok && v != "" {
+				td, err := commons.ExpandECSHeadroomTaskDefinition(Headroom, m)
+				if err != nil {
+					return nil, err
+				}
+				headroom.SetCPUPerUnit(spotinst.Int(td.CPU))
+				headroom.SetMemoryPerUnit(spotinst.Int(td.Memory))
+			} else {
+				if v, ok := m[string(CpuPerUnit)].(int); ok && v >= 0 {
+					headroom.SetCPUPerUnit(spotinst.Int(v))
+				}
 
-			if v, ok := m[string(MemoryPerUnit)].(int); ok && v >= 0 {
-				headroom.SetMemoryPerUnit(spotinst.Int(v))
+				if v, ok := m[string(MemoryPerUnit)].(int); ok && v >= 0 {
+					headroom.SetMemoryPerUnit(spotinst.Int(v))
+				}
 			}
 
 			if v, ok := m[string(NumOfUnits)].(int); ok && v >= 0 {
@@ -250,6 +266,54 @@ func expandOceanAWSAutoScalerHeadroom(data interface{}) (*aws.ECSAutoScalerHeadr
 	return nil, nil
 }
 
+// CustomizeDiff checks at plan time that the tasks of a headroom given as a
+// task definition fit on at least one of the instance types listed in the
+// instanceTypesField of the cluster. The custom attributes of the launch
+// specs are not known here, so only ecs.* placement constraints are checked.
+func CustomizeDiff(diff *schema.ResourceDiff, instanceTypesField string) error {
+	key := fmt.Sprintf("%s.0.%s", Autoscaler, Headroom)
+	if diff.Id() != "" && !diff.HasChanges(key, instanceTypesField) {
+		return nil
+	}
+	if !diff.NewValueKnown(key) || !diff.NewValueKnown(instanceTypesField) {
+		return nil
+	}
+
+	list, _ := diff.Get(key).([]interface{})
+	if len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+	taskDefinition, _ := m[string(TaskDefinition)].(string)
+	if taskDefinition == "" {
+		return nil
+	}
+	td, err := commons.ExpandECSHeadroomTaskDefinition(Headroom, m)
+	if err != nil {
+		return err
+	}
+
+	var instanceTypes []string
+	if v, ok := diff.Get(instanceTypesField).([]interface{}); ok {
+		for _, instanceType := range v {
+			if s, ok := instanceType.(string); ok && s != "" {
+				instanceTypes = append(instanceTypes, s)
+			}
+		}
+	}
+	if len(instanceTypes) == 0 {
+		return nil
+	}
+	instances, err := commons.ECSInstances(instanceTypes, nil)
+	if err != nil {
+		return err
+	}
+	if err := td.PlaceableOnAny(instances); err != nil {
+		return fmt.Errorf("%s: %v", Headroom, err)
+	}
+	return nil
+}
+
 func expandOceanAWSAutoScalerResourceLimits(data interface{}) (*aws.ECSAutoScalerResourceLimits, error) {
 	if list := data.([]interface{}); len(list) > 0 {
 		resLimits := &aws.ECSAutoScalerResourceLimits{}
@@ -295,7 +359,7 @@ func expandOceanAWSAutoScalerDown(data interface{}) (*aws.ECSAutoScalerDown, err
 	return nil, nil
 }
 
-func flattenAutoscaler(autoScaler *aws.ECSAutoScaler) []interface{} {
+func flattenAutoscaler(autoScaler *aws.ECSAutoScaler, taskDefinition string) []interface{} {
 	var out []interface{}
 
 	if autoScaler != nil {
@@ -309,7 +373,7 @@ func flattenAutoscaler(autoScaler *aws.ECSAutoScaler) []interface{} {
 		result[string(EnableAutomaticAndManualHeadroom)] = spotinst.BoolValue(autoScaler.EnableAutomaticAndManualHeadroom)
 
 		if autoScaler.Headroom != nil {
-			result[string(Headroom)] = flattenAutoScaleHeadroom(autoScaler.Headroom)
+			result[string(Headroom)] = flattenAutoScaleHeadroom(autoScaler.Headroom, taskDefinition)
 		}
 
 		if autoScaler.Down != nil {
@@ -335,8 +399,22 @@ func flattenAutoScaleDown(autoScaleDown *aws.ECSAutoScalerDown) []interface{} {
 	return []interface{}{down}
 }
 
-func flattenAutoScaleHeadroom(autoScaleHeadroom *aws.ECSAutoScalerHeadroom) []interface{} {
+// flattenAutoScaleHeadroom keeps the configured task definition as long as
+// the headroom still holds the CPU and memory computed from it.
+func flattenAutoScaleHeadroom(autoScaleHeadroom *aws.ECSAutoScalerHeadroom, taskDefinition string) []interface{} {
 	headRoom := make(map[string]interface{})
+	if taskDefinition != "" {
+		if td, err := commons.ParseECSTaskDefinition(taskDefinition); err == nil &&
+			td.CPU == spotinst.IntValue(autoScaleHeadroom.CPUPerUnit) &&
+			td.Memory == spotinst.IntValue(autoScaleHeadroom.MemoryPerUnit) {
+			headRoom[string(TaskDefinition)] = taskDefinition
+			headRoom[string(CpuPerUnit)] = 0
+			headRoom[string(MemoryPerUnit)] = 0
+			headRoom[string(NumOfUnits)] = spotinst.IntValue(autoScaleHeadroom.NumOfUnits)
+			return []interface{}{headRoom}
+		}
+	}
+
 	headRoom[string(CpuPerUnit)] = spotinst.IntValue(autoScaleHeadroom.CPUPerUnit)
 	headRoom[string(MemoryPerUnit)] = spotinst.IntValue(autoScaleHeadroom.MemoryPerUnit)
 	headRoom[string(NumOfUnits)] = spotinst.IntValue(autoScaleHeadroom.NumOfUnits)
@@ -7,9 +7,10 @@ type IAMField string
 type TagField string
 
 const (
-	CPUPerUnit    commons.FieldName = "cpu_per_unit"
-	MemoryPerUnit commons.FieldName = "memory_per_unit"
-	NumOfUnits    commons.FieldName = "num_of_units"
+	CPUPerUnit     commons.FieldName = "cpu_per_unit"
+	MemoryPerUnit  commons.FieldName = "memory_per_unit"
+	NumOfUnits     commons.FieldName = "num_of_units"
+	TaskDefinition commons.FieldName = "task_definition"
 )
 
 const (
@@ -430,6 +430,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeInt,
 						Required: true,
 					},
+
+					string(TaskDefinition): {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: commons.ValidateECSTaskDefinition,
+					},
 				},
 			},
 		},
@@ -439,7 +445,7 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			var result []interface{} = nil
 			if launchSpec.AutoScale != nil && launchSpec.AutoScale.Headrooms != nil {
 				headrooms := launchSpec.AutoScale.Headrooms
-				result = flattenHeadrooms(headrooms)
+				result = flattenHeadrooms(headrooms, resourceData.Get(string(AutoscaleHeadrooms)))
 			}
 			if result != nil {
 				if err := resourceData.Set(string(AutoscaleHeadrooms), result); err != nil {
@@ -596,9 +602,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(VolumeType): {
-									Type:     schema.TypeString,
-									Optional: true,
-									Computed: true,
+									Type:         schema.TypeString,
+									Optional:     true,
+									Computed:     true,
+									ValidateFunc: validation.StringInSlice(commons.AWSVolumeTypes, true),
 								},
 
 								string(DynamicVolumeSize): {
@@ -843,8 +850,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.OceanAWSLaunchSpec,
 		SubnetIDs,
 		&schema.Schema{
-			Type:     schema.TypeList,
-			Elem:     &schema.Schema{Type: schema.TypeString},
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: commons.ValidateSubnetID,
+			},
 			Optional: true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
@@ -903,8 +913,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 					string(TaskType): {
 						Type:     schema.TypeString,
@@ -1053,8 +1064,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(HTTPTokens): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"optional", "required"}, false),
 					},
 
 					string(HTTPPutResponseHopLimit): {
@@ -1224,12 +1236,33 @@ func expandHeadrooms(data interface{}) ([]*aws.ECSAutoScaleHeadroom, error) {
 			MemoryPerUnit: spotinst.Int(attr[string(MemoryPerUnit)].(int)),
 		}
 
+		if taskDefinition, ok := attr[string(TaskDefinition)].(string); ok && taskDefinition != "" {
+			td, err := commons.ExpandECSHeadroomTaskDefinition(AutoscaleHeadrooms, attr)
+			if err != nil {
+				return nil, err
+			}
+			headroom.SetCPUPerUnit(spotinst.Int(td.CPU))
+			headroom.SetMemoryPerUnit(spotinst.Int(td.Memory))
+		}
+
 		headrooms = append(headrooms, headroom)
 	}
 	return headrooms, nil
 }
 
-func flattenHeadrooms(headrooms []*aws.ECSAutoScaleHeadroom) []interface{} {
+// flattenHeadrooms keeps the configured task definition of a headroom for as
+// long as the API still returns the CPU and memory computed from it, so such
+// headrooms don't show a diff.
+func flattenHeadrooms(headrooms []*aws.ECSAutoScaleHeadroom, current interface{}) []interface{} {
+	var taskDefinitions []map[string]interface{}
+	if set, ok := current.(*schema.Set); ok {
+		for _, v := range set.List() {
+			if attr, ok := v.(map[string]interface{}); ok && attr[string(TaskDefinition)] != "" {
+				taskDefinitions = append(taskDefinitions, attr)
+			}
+		}
+	}
+
 	result := make([]interface{}, 0, len(headrooms))
 
 	for _, headroom := range headrooms {
@@ -1238,12 +1271,83 @@ func flattenHeadrooms(headrooms []*aws.ECSAutoScaleHeadroom) []interface{} {
 		m[string(NumOfUnits)] = spotinst.IntValue(headroom.NumOfUnits)
 		m[string(MemoryPerUnit)] = spotinst.IntValue(headroom.MemoryPerUnit)
 
+		for i, attr := range taskDefinitions {
+			taskDefinition, _ := attr[string(TaskDefinition)].(string)
+			td, err := commons.ParseECSTaskDefinition(taskDefinition)
+			if err != nil || td.CPU != spotinst.IntValue(headroom.CPUPerUnit) ||
+				td.Memory != spotinst.IntValue(headroom.MemoryPerUnit) ||
+				attr[string(NumOfUnits)] != spotinst.IntValue(headroom.NumOfUnits) {
+				continue
+			}
+			m[string(TaskDefinition)] = taskDefinition
+			m[string(CPUPerUnit)] = 0
+			m[string(MemoryPerUnit)] = 0
+			taskDefinitions = append(taskDefinitions[:i], taskDefinitions[i+1:]...)
+			break
+		}
+
 		result = append(result, m)
 	}
 
 	return result
 }
 
+// CustomizeDiff checks at plan time that the tasks of every headroom given
+// as a task definition fit on at least one of the launch spec instance types
+// and satisfy the placement constraints of the task definition against the
+// launch spec attributes.
+func CustomizeDiff(diff *schema.ResourceDiff) error {
+	fields := []string{string(AutoscaleHeadrooms), string(InstanceTypes), string(Attributes)}
+	if diff.Id() != "" && !diff.HasChanges(fields[0], fields[1], fields[2]) {
+		return nil
+	}
+	for _, field := range fields {
+		if !diff.NewValueKnown(field) {
+			return nil
+		}
+	}
+
+	var taskDefinitions []*commons.ECSTaskDefinition
+	for _, v := range diff.Get(string(AutoscaleHeadrooms)).(*schema.Set).List() {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if taskDefinition, ok := attr[string(TaskDefinition)].(string); ok && taskDefinition != "" {
+			td, err := commons.ExpandECSHeadroomTaskDefinition(AutoscaleHeadrooms, attr)
+			if err != nil {
+				return err
+			}
+			taskDefinitions = append(taskDefinitions, td)
+		}
+	}
+	if len(taskDefinitions) == 0 {
+		return nil
+	}
+
+	instanceTypes, err := expandInstanceTypes(diff.Get(string(InstanceTypes)))
+	if err != nil {
+		return err
+	}
+	attributes := make(map[string]string)
+	for _, v := range diff.Get(string(Attributes)).(*schema.Set).List() {
+		if attr, ok := v.(map[string]interface{}); ok {
+			attributes[attr[string(AttributeKey)].(string)] = attr[string(AttributeValue)].(string)
+		}
+	}
+	instances, err := commons.ECSInstances(instanceTypes, attributes)
+	if err != nil {
+		return err
+	}
+
+	for _, td := range taskDefinitions {
+		if err := td.PlaceableOnAny(instances); err != nil {
+			return fmt.Errorf("%s: %v", AutoscaleHeadrooms, err)
+		}
+	}
+	return nil
+}
+
 func expandTags(data interface{}) ([]*aws.Tag, error) {
 	list := data.(*schema.Set).List()
 	tags := make([]*aws.Tag, 0, len(list))
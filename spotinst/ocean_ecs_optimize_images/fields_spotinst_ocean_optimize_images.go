@@ -29,7 +29,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Type:     schema.TypeList,
 						MinItems: 1,
 						Optional: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: commons.ValidateTimeWindow,
+						},
 					},
 					string(ShouldOptimizeECSAMI): {
 						Type:     schema.TypeBool,
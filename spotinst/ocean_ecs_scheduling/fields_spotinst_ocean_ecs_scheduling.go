@@ -35,8 +35,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								},
 
 								string(CronExpression): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: commons.ValidateCronExpression,
 								},
 							},
 						},
@@ -55,7 +56,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(TimeWindows): {
 									Type:     schema.TypeList,
 									Required: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: commons.ValidateTimeWindow,
+									},
 								},
 							},
 						},
@@ -8,6 +8,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/instance_catalog"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -373,7 +374,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		nil,
 		nil,
 		nil,
-	)
+	).AddStateUpgrader(0, func(rawState map[string]interface{}) error {
+		// Version 1 moves the value of the deprecated cluster_controller_id
+		// attribute to controller_cluster_id.
+		commons.CopyStateAttribute(rawState, string(ClusterControllerID), string(ControllerClusterID))
+		return nil
+	})
 
 	fieldsMap[ControllerClusterID] = commons.NewGenericField(
 		commons.OceanGKEImport,
@@ -826,3 +832,29 @@ func expandAutoUpdate(data interface{}) (*gcp.AutoUpdate, error) {
 	}
 	return autoUpdate, nil
 }
+
+// CustomizeDiff checks whitelist and blacklist against the instance type
+// catalog and fails on machine types of known families that it does not list,
+// which are almost always typos. Only complete catalogs are checked.
+func CustomizeDiff(diff *schema.ResourceDiff) error {
+	catalog, err := instance_catalog.Load(instance_catalog.GCP)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range []commons.FieldName{Whitelist, Blacklist} {
+		if !diff.NewValueKnown(string(field)) {
+			continue
+		}
+		var names []string
+		for _, v := range diff.Get(string(field)).([]interface{}) {
+			if name, ok := v.(string); ok && name != "" {
+				names = append(names, name)
+			}
+		}
+		if unknown := catalog.UnknownTypes(names); len(unknown) > 0 {
+			return catalog.UnknownTypesError(string(field), unknown)
+		}
+	}
+	return nil
+}
@@ -25,8 +25,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						Required: true,
 					},
 					string(CronExpression): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: commons.ValidateCronExpression,
 					},
 					string(TaskType): {
 						Type:     schema.TypeString,
@@ -124,7 +125,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					string(TimeWindows): {
 						Type:     schema.TypeList,
 						Required: true,
-						Elem:     &schema.Schema{Type: schema.TypeString},
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: commons.ValidateTimeWindow,
+						},
 					},
 				},
 			},
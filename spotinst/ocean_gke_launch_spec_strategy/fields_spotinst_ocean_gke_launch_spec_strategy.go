@@ -26,8 +26,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 						ValidateFunc: validation.IntAtLeast(-1),
 					},
 					string(ScalingOrientation): {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"cost", "availability", "balanced"}, false),
 					},
 				},
 			},
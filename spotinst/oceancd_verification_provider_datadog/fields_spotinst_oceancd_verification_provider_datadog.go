@@ -11,6 +11,8 @@ import (
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("datadog.apiKey", "datadog.appKey")
+
 	fieldsMap[Datadog] = commons.NewGenericField(
 		commons.OceanCDVerificationProviderDataDog,
 		Datadog,
@@ -26,13 +28,15 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(ApiKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Required:  true,
 					},
 
 					string(AppKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Required:  true,
 					},
 				},
 			},
@@ -11,6 +11,8 @@ import (
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("jenkins.apiToken")
+
 	fieldsMap[Jenkins] = commons.NewGenericField(
 		commons.OceanCDVerificationProviderJenkins,
 		Jenkins,
@@ -21,8 +23,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					string(ApiToken): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Required:  true,
 					},
 
 					string(BaseUrl): {
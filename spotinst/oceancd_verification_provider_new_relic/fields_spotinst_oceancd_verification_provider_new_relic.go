@@ -11,6 +11,8 @@ import (
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("newRelic.personalApiKey")
+
 	fieldsMap[NewRelic] = commons.NewGenericField(
 		commons.OceanCDVerificationProviderNewRelic,
 		NewRelic,
@@ -36,8 +38,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 					},
 
 					string(PersonalApiKey): {
-						Type:     schema.TypeString,
-						Required: true,
+						Type:      schema.TypeString,
+						Sensitive: true,
+						Required:  true,
 					},
 
 					string(Region): {
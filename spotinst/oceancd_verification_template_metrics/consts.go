@@ -99,3 +99,14 @@ const (
 	ContainerName commons.FieldName = "container_name"
 	RestartPolicy commons.FieldName = "restart_policy"
 )
+
+// cloudWatchUnits are the units CloudWatch accepts for a metric stat.
+var cloudWatchUnits = []string{
+	"Seconds", "Microseconds", "Milliseconds",
+	"Bytes", "Kilobytes", "Megabytes", "Gigabytes", "Terabytes",
+	"Bits", "Kilobits", "Megabits", "Gigabits", "Terabits",
+	"Percent", "Count",
+	"Bytes/Second", "Kilobytes/Second", "Megabytes/Second", "Gigabytes/Second", "Terabytes/Second",
+	"Bits/Second", "Kilobits/Second", "Megabits/Second", "Gigabits/Second", "Terabits/Second",
+	"Count/Second", "None",
+}
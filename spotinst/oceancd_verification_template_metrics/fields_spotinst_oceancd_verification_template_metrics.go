@@ -6,6 +6,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/oceancd"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 )
@@ -84,8 +85,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 									Default:  -1,
 								},
 								string(Threshold): {
-									Type:     schema.TypeString,
-									Required: true,
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice([]string{"<", ">", "<=", ">=", "=", "range"}, false),
 								},
 								string(BaseLineProvider): {
 									Type:     schema.TypeList,
@@ -245,8 +247,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 																		Optional: true,
 																	},
 																	string(Unit): {
-																		Type:     schema.TypeString,
-																		Optional: true,
+																		Type:         schema.TypeString,
+																		Optional:     true,
+																		ValidateFunc: validation.StringInSlice(cloudWatchUnits, true),
 																	},
 																	string(MetricPeriod): {
 																		Type:     schema.TypeInt,
@@ -321,8 +324,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 																		Elem: &schema.Resource{
 																			Schema: map[string]*schema.Schema{
 																				string(RestartPolicy): {
-																					Type:     schema.TypeString,
-																					Required: true,
+																					Type:         schema.TypeString,
+																					Required:     true,
+																					ValidateFunc: validation.StringInSlice([]string{"Never", "OnFailure"}, true),
 																				},
 																				string(Containers): {
 																					Type:     schema.TypeSet,
@@ -7,6 +7,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/policy_catalog"
 )
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
@@ -103,7 +104,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(Actions): {
 									Type:     schema.TypeList,
 									Required: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:             schema.TypeString,
+										ValidateDiagFunc: policy_catalog.ValidateAction,
+									},
 								},
 
 								string(Effect): {
@@ -114,7 +118,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 								string(Resources): {
 									Type:     schema.TypeList,
 									Required: true,
-									Elem:     &schema.Schema{Type: schema.TypeString},
+									Elem: &schema.Schema{
+										Type:             schema.TypeString,
+										ValidateDiagFunc: policy_catalog.ValidateResource,
+									},
 								},
 							},
 						},
@@ -0,0 +1,10 @@
+package organization_policy_attachment
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	PolicyID    commons.FieldName = "policy_id"
+	UserGroupID commons.FieldName = "user_group_id"
+	UserID      commons.FieldName = "user_id"
+	AccountIDs  commons.FieldName = "account_ids"
+)
@@ -0,0 +1,139 @@
+package organization_policy_attachment
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[PolicyID] = commons.NewGenericField(
+		commons.OrganizationPolicyAttachment,
+		PolicyID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			if err := resourceData.Set(string(PolicyID), spotinst.StringValue(attachment.PolicyID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(PolicyID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			attachment.PolicyID = spotinst.String(resourceData.Get(string(PolicyID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[UserGroupID] = commons.NewGenericField(
+		commons.OrganizationPolicyAttachment,
+		UserGroupID,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{string(UserGroupID), string(UserID)},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			var value *string = nil
+			if attachment.UserGroupID != nil {
+				value = attachment.UserGroupID
+			}
+			if err := resourceData.Set(string(UserGroupID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserGroupID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			if v, ok := resourceData.GetOk(string(UserGroupID)); ok && v != "" {
+				attachment.UserGroupID = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[UserID] = commons.NewGenericField(
+		commons.OrganizationPolicyAttachment,
+		UserID,
+		&schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{string(UserGroupID), string(UserID)},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			var value *string = nil
+			if attachment.UserID != nil {
+				value = attachment.UserID
+			}
+			if err := resourceData.Set(string(UserID), value); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			if v, ok := resourceData.GetOk(string(UserID)); ok && v != "" {
+				attachment.UserID = spotinst.String(v.(string))
+			}
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[AccountIDs] = commons.NewGenericField(
+		commons.OrganizationPolicyAttachment,
+		AccountIDs,
+		&schema.Schema{
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			if err := resourceData.Set(string(AccountIDs), attachment.AccountIDs); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(AccountIDs), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			attachment.AccountIDs = expandAccountIDs(resourceData.Get(string(AccountIDs)))
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			attachment := resourceObject.(*commons.OrgPolicyAttachmentWrapper).GetAttachment()
+			attachment.AccountIDs = expandAccountIDs(resourceData.Get(string(AccountIDs)))
+			return nil
+		},
+		nil,
+	)
+}
+
+func expandAccountIDs(data interface{}) []string {
+	list := data.(*schema.Set).List()
+	result := make([]string, 0, len(list))
+
+	for _, v := range list {
+		if accountID, ok := v.(string); ok && accountID != "" {
+			result = append(result, accountID)
+		}
+	}
+	return result
+}
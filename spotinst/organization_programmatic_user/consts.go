@@ -12,4 +12,8 @@ const (
 	PolicyAccountIds commons.FieldName = "policy_account_ids"
 	PolicyId         commons.FieldName = "policy_id"
 	UserGroupIds     commons.FieldName = "user_group_ids"
+	Token            commons.FieldName = "token"
+	TokenCreatedAt   commons.FieldName = "token_created_at"
+	RotationTriggers commons.FieldName = "rotation_triggers"
+	RotateAfterDays  commons.FieldName = "rotate_after_days"
 )
@@ -2,8 +2,10 @@ package organization_programmatic_user
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -262,6 +264,106 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	commons.RegisterSensitivePaths("items.token")
+
+	fieldsMap[Token] = commons.NewGenericField(
+		commons.OrganizationProgrammaticUser,
+		Token,
+		&schema.Schema{
+			Type:      schema.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// The token is only returned when the user is created, so keep
+			// the one in state unless the API sends a new one.
+			orgProgrammaticUserWrapper := resourceObject.(*commons.OrgProgrammaticUserWrapper)
+			orgProgrammaticUser := orgProgrammaticUserWrapper.GetOrgProgrammaticUser()
+			if orgProgrammaticUser.Token != nil {
+				if err := resourceData.Set(string(Token), spotinst.StringValue(orgProgrammaticUser.Token)); err != nil {
+					return fmt.Errorf(string(commons.FailureFieldReadPattern), string(Token), err)
+				}
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[TokenCreatedAt] = commons.NewGenericField(
+		commons.OrganizationProgrammaticUser,
+		TokenCreatedAt,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			// The API does not return when the token was created. Users
+			// created before this attribute existed have no value, so start
+			// counting from the first read rather than never rotating them.
+			if resourceData.Get(string(TokenCreatedAt)).(string) != "" {
+				return nil
+			}
+			if err := resourceData.Set(string(TokenCreatedAt), time.Now().UTC().Format(time.RFC3339)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(TokenCreatedAt), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[RotationTriggers] = commons.NewGenericField(
+		commons.OrganizationProgrammaticUser,
+		RotationTriggers,
+		&schema.Schema{
+			Type:     schema.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
+
+	fieldsMap[RotateAfterDays] = commons.NewGenericField(
+		commons.OrganizationProgrammaticUser,
+		RotateAfterDays,
+		&schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		nil,
+	)
 }
 
 func expandPolicies(data interface{}) ([]*organization.ProgPolicy, error) {
@@ -11,6 +11,8 @@ import (
 
 func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 
+	commons.RegisterSensitivePaths("password")
+
 	fieldsMap[Email] = commons.NewGenericField(
 		commons.OrganizationUser,
 		Email,
@@ -102,9 +104,10 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.OrganizationUser,
 		Password,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:      schema.TypeString,
+			Sensitive: true,
+			Optional:  true,
+			Computed:  true,
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			return nil
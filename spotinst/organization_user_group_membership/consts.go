@@ -0,0 +1,8 @@
+package organization_user_group_membership
+
+import "github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+
+const (
+	UserGroupID commons.FieldName = "user_group_id"
+	UserID      commons.FieldName = "user_id"
+)
@@ -0,0 +1,60 @@
+package organization_user_group_membership
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
+
+	fieldsMap[UserGroupID] = commons.NewGenericField(
+		commons.OrganizationUserGroupMembership,
+		UserGroupID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			membership := resourceObject.(*commons.OrgUserGroupMembershipWrapper).GetMembership()
+			if err := resourceData.Set(string(UserGroupID), spotinst.StringValue(membership.UserGroupID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserGroupID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			membership := resourceObject.(*commons.OrgUserGroupMembershipWrapper).GetMembership()
+			membership.UserGroupID = spotinst.String(resourceData.Get(string(UserGroupID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+
+	fieldsMap[UserID] = commons.NewGenericField(
+		commons.OrganizationUserGroupMembership,
+		UserID,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			membership := resourceObject.(*commons.OrgUserGroupMembershipWrapper).GetMembership()
+			if err := resourceData.Set(string(UserID), spotinst.StringValue(membership.UserID)); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(UserID), err)
+			}
+			return nil
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			membership := resourceObject.(*commons.OrgUserGroupMembershipWrapper).GetMembership()
+			membership.UserID = spotinst.String(resourceData.Get(string(UserID)).(string))
+			return nil
+		},
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,191 @@
+// Package policy_catalog provides an embedded catalog of the actions and
+// resource ID prefixes that Spot access policies accept, used to validate
+// policy statements at plan time and to expand action wildcards.
+//
+// Unknown actions and resource IDs are reported as warnings rather than
+// errors, so a catalog that lags behind the API never blocks a plan. A
+// directory holding a newer catalog.json can be used without a new provider
+// release by pointing SPOTINST_POLICY_CATALOG_DIR at it.
+package policy_catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// EnvCatalogDir is the environment variable naming a directory with a
+// catalog.json that takes precedence over the embedded one.
+const EnvCatalogDir = "SPOTINST_POLICY_CATALOG_DIR"
+
+// Wildcard matches every action, or every resource of a statement.
+const Wildcard = "*"
+
+//go:embed catalog.json
+var embedded []byte
+
+type Catalog struct {
+	UpdatedAt        string            `json:"updatedAt"`
+	Actions          []string          `json:"actions"`
+	ResourcePrefixes map[string]string `json:"resourcePrefixes"`
+
+	actions  map[string]bool
+	services map[string][]string
+}
+
+var catalog struct {
+	sync.Mutex
+	loaded *Catalog
+}
+
+// Load returns the catalog, reading it once per process.
+func Load() (*Catalog, error) {
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	if catalog.loaded != nil {
+		return catalog.loaded, nil
+	}
+
+	data := embedded
+	if dir := os.Getenv(EnvCatalogDir); dir != "" {
+		var err error
+		if data, err = os.ReadFile(filepath.Join(dir, "catalog.json")); err != nil {
+			return nil, fmt.Errorf("failed to read the policy catalog: %v", err)
+		}
+	}
+
+	c := &Catalog{}
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("failed to parse the policy catalog: %v", err)
+	}
+	c.index()
+
+	catalog.loaded = c
+	return c, nil
+}
+
+func (c *Catalog) index() {
+	c.actions = make(map[string]bool, len(c.Actions))
+	c.services = make(map[string][]string)
+	for _, action := range c.Actions {
+		c.actions[action] = true
+		service := action[:strings.Index(action, ":")]
+		c.services[service] = append(c.services[service], action)
+	}
+}
+
+// actionRegexp matches the form of an action. It is deliberately loose about
+// the characters of the names, so that actions added to the API after the
+// catalog was generated are warned about rather than rejected.
+var actionRegexp = regexp.MustCompile(`^[A-Za-z0-9_]+:[A-Za-z0-9_*]+$`)
+
+// CheckAction validates an action name or pattern. Malformed actions are
+// errors; actions the catalog does not know are returned as a warning,
+// with the closest known action when there is one.
+func (c *Catalog) CheckAction(action string) (warning string, err error) {
+	if action == Wildcard {
+		return "", nil
+	}
+	if !actionRegexp.MatchString(action) {
+		return "", fmt.Errorf("invalid action %q: actions have the form service:action, e.g. ocean:createCluster", action)
+	}
+
+	service := action[:strings.Index(action, ":")]
+	if _, ok := c.services[service]; !ok {
+		return fmt.Sprintf("unknown service %q in action %q", service, action), nil
+	}
+	if strings.Contains(action, Wildcard) {
+		if len(c.Expand(action)) == 0 {
+			return fmt.Sprintf("action %q matches no known action", action), nil
+		}
+		return "", nil
+	}
+	if c.actions[action] {
+		return "", nil
+	}
+	if suggestion := c.closestAction(action); suggestion != "" {
+		return fmt.Sprintf("unknown action %q, did you mean %q?", action, suggestion), nil
+	}
+	return fmt.Sprintf("unknown action %q", action), nil
+}
+
+// Expand returns the known actions matching an action pattern that may use
+// the asterisk wildcard, e.g. "ocean:create*", sorted. An action without
+// wildcards expands to itself, known or not.
+func (c *Catalog) Expand(pattern string) []string {
+	if !strings.Contains(pattern, Wildcard) {
+		return []string{pattern}
+	}
+
+	var actions []string
+	for _, action := range c.Actions {
+		if ok, err := path.Match(pattern, action); err == nil && ok {
+			actions = append(actions, action)
+		}
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// closestAction returns the known action of the same service closest to
+// action, ignoring case, if it is close enough to be a likely typo.
+func (c *Catalog) closestAction(action string) string {
+	service := action[:strings.Index(action, ":")]
+
+	best, bestDistance := "", len(action)/3+1
+	for _, known := range c.services[service] {
+		if d := distance(strings.ToLower(action), strings.ToLower(known)); d < bestDistance {
+			best, bestDistance = known, d
+		}
+	}
+	return best
+}
+
+// CheckResource validates a resource ID. Malformed IDs are errors; IDs
+// whose prefix the catalog does not know are returned as a warning.
+func (c *Catalog) CheckResource(id string) (warning string, err error) {
+	if id == Wildcard {
+		return "", nil
+	}
+	if id == "" || strings.TrimSpace(id) != id || strings.ContainsAny(id, " \t\n") {
+		return "", fmt.Errorf("invalid resource %q: resources are resource IDs, e.g. o-abcd1234, or %q", id, Wildcard)
+	}
+
+	i := strings.Index(id, "-")
+	if i <= 0 || i == len(id)-1 {
+		return fmt.Sprintf("resource %q is not a Spot resource ID", id), nil
+	}
+	if _, ok := c.ResourcePrefixes[id[:i+1]]; !ok {
+		return fmt.Sprintf("unknown resource ID prefix %q in %q", id[:i+1], id), nil
+	}
+	return "", nil
+}
+
+// distance returns the Levenshtein distance between a and b.
+func distance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,145 @@
+package policy_catalog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestCheckAction(t *testing.T) {
+	c, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		action  string
+		warning string
+		err     bool
+	}{
+		{"*", "", false},
+		{"ocean:createCluster", "", false},
+		{"ocean:delete*", "", false},
+		{"ocean:nothing*", "matches no known action", false},
+		{"ocean:createClustr", `did you mean "ocean:createCluster"?`, false},
+		{"ocean:somethingElseEntirely", `unknown action "ocean:somethingElseEntirely"`, false},
+		{"unknown:createCluster", `unknown service "unknown"`, false},
+		{"ocean:createClusterV2", `unknown action "ocean:createClusterV2"`, false},
+		{"ocean:create_cluster", `unknown action "ocean:create_cluster"`, false},
+		{"ec2_v2:describe*", `unknown service "ec2_v2"`, false},
+		{"createCluster", "", true},
+		{"ocean:create-cluster", "", true},
+		{"ocean:", "", true},
+		{"ocean:create cluster", "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.action, func(t *testing.T) {
+			warning, err := c.CheckAction(tc.action)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if tc.warning == "" && warning != "" || !strings.Contains(warning, tc.warning) {
+				t.Errorf("expected warning containing %q, got %q", tc.warning, warning)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	c, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		pattern  string
+		expected []string
+	}{
+		{"ocean:deleteCluster", []string{"ocean:deleteCluster"}},
+		{"ocean:unknownAction", []string{"ocean:unknownAction"}},
+		{"ocean:delete*", []string{"ocean:deleteCluster", "ocean:deleteExtendedResourceDefinition", "ocean:deleteLaunchSpec", "ocean:deleteRightSizingRule"}},
+		{"ocean:nothing*", nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			got := c.Expand(tc.pattern)
+			if strings.Join(got, ",") != strings.Join(tc.expected, ",") {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestCheckResource(t *testing.T) {
+	c, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		id      string
+		warning string
+		err     bool
+	}{
+		{"*", "", false},
+		{"o-abcd1234", "", false},
+		{"ols-abcd1234", "", false},
+		{"zz-abcd1234", `unknown resource ID prefix "zz-"`, false},
+		{"cluster", "is not a Spot resource ID", false},
+		{"o-", "is not a Spot resource ID", false},
+		{"", "", true},
+		{" o-abcd1234", "", true},
+		{"o-abcd 1234", "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.id, func(t *testing.T) {
+			warning, err := c.CheckResource(tc.id)
+			if (err != nil) != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err, err)
+			}
+			if tc.warning == "" && warning != "" || !strings.Contains(warning, tc.warning) {
+				t.Errorf("expected warning containing %q, got %q", tc.warning, warning)
+			}
+		})
+	}
+}
+
+func TestValidateAction(t *testing.T) {
+	path := cty.GetAttrPath("actions")
+
+	if diags := ValidateAction("ocean:createCluster", path); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+
+	diags := ValidateAction("ocean:createClustr", path)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected one warning, got %v", diags)
+	}
+	if !strings.HasPrefix(diags[0].Detail, "Unknown action") {
+		t.Errorf("expected a capitalized detail, got %q", diags[0].Detail)
+	}
+
+	diags = ValidateAction("createCluster", path)
+	if len(diags) != 1 || diags[0].Severity != diag.Error {
+		t.Fatalf("expected one error, got %v", diags)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"createcluster", "createclustr", 1},
+	}
+	for _, tc := range cases {
+		if got := distance(tc.a, tc.b); got != tc.expected {
+			t.Errorf("distance(%q, %q): expected %d, got %d", tc.a, tc.b, tc.expected, got)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package policy_catalog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// ValidateAction is a schema ValidateDiagFunc for the actions of a policy
+// statement.
+func ValidateAction(v interface{}, path cty.Path) diag.Diagnostics {
+	return check(v, path, "action", (*Catalog).CheckAction)
+}
+
+// ValidateResource is a schema ValidateDiagFunc for the resources of a
+// policy statement.
+func ValidateResource(v interface{}, path cty.Path) diag.Diagnostics {
+	return check(v, path, "resource", (*Catalog).CheckResource)
+}
+
+func check(v interface{}, path cty.Path, kind string, checkFunc func(*Catalog, string) (string, error)) diag.Diagnostics {
+	c, err := Load()
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("Policy %s not validated", kind),
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+
+	warning, err := checkFunc(c, v.(string))
+	if err != nil {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid policy %s", kind),
+				Detail:        err.Error(),
+				AttributePath: path,
+			},
+		}
+	}
+	if warning != "" {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Warning,
+				Summary:       fmt.Sprintf("Unknown policy %s", kind),
+				Detail:        fmt.Sprintf("%s The policy catalog (updated %s) may be out of date; if the %s is valid, it is passed to the API as is.", sentence(warning), c.UpdatedAt, kind),
+				AttributePath: path,
+			},
+		}
+	}
+	return nil
+}
+
+// sentence capitalizes a message and ends it with a period.
+func sentence(s string) string {
+	if s == "" {
+		return s
+	}
+	if s[0] >= 'a' && s[0] <= 'z' {
+		s = string(s[0]-'a'+'A') + s[1:]
+	}
+	if !strings.HasSuffix(s, "?") && !strings.HasSuffix(s, ".") {
+		s += "."
+	}
+	return s
+}
@@ -20,8 +20,9 @@ func Provider() *schema.Provider {
 			},
 
 			string(commons.ProviderToken): {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Optional:  true,
 				//DefaultFunc: schema.EnvDefaultFunc(credentials.EnvCredentialsVarToken, ""),
 				Description: "Spotinst Personal API Access Token",
 			},
@@ -92,6 +93,12 @@ func Provider() *schema.Provider {
 			// Organization User Group
 			string(commons.OrgUserGroupResourceName): resourceOrgUserGroup(),
 
+			// Organization User Group Membership
+			string(commons.OrgUserGroupMembershipResourceName): resourceOrgUserGroupMembership(),
+
+			// Organization Policy Attachment
+			string(commons.OrgPolicyAttachmentResourceName): resourceOrgPolicyAttachment(),
+
 			// AWS Account Creation
 			string(commons.AccountAWSResourceName): resourceSpotinstAccountAWS(),
 
@@ -123,10 +130,36 @@ func Provider() *schema.Provider {
 			string(commons.CredentialsAzureResourceName): resourceSpotinstCredentialsAzure(),
 
 			// Account Creation
-			string(commons.AccountResourceName): resourceSpotinstAccount(),
+			string(commons.AccountResourceName):           resourceSpotinstAccount(),
+			string(commons.AccountOnboardingResourceName): resourceSpotinstAccountOnboarding(),
 
 			//Notification Center Policy
 			string(commons.NotificationCenterResourceName): resourceSpotinstNotificationCenter(),
+
+			// Scheduled Tasks
+			string(commons.ElastigroupAWSScheduledTaskResourceName): resourceSpotinstElastigroupAWSScheduledTask(),
+			string(commons.OceanAWSScheduledTaskResourceName):       resourceSpotinstOceanAWSScheduledTask(),
+
+			// Scaling Policies
+			string(commons.ElastigroupAWSScalingPolicyResourceName): resourceSpotinstElastigroupAWSScalingPolicy(),
+		},
+
+		DataSourcesMap: map[string]*schema.Resource{
+			// Ocean.
+			string(commons.OceanAWSInstanceTypesDataSourceName): dataSourceSpotinstOceanAWSInstanceTypes(),
+			string(commons.OceanGKEMachineTypesDataSourceName):  dataSourceSpotinstOceanGKEMachineTypes(),
+			string(commons.OceanAKSVmSizesDataSourceName):       dataSourceSpotinstOceanAKSVmSizes(),
+
+			string(commons.OceanAWSExtendedResourceDefinitionsDataSourceName): dataSourceSpotinstOceanAWSExtendedResourceDefinitions(),
+
+			// Organization.
+			string(commons.OrganizationPolicyDocumentDataSourceName): dataSourceSpotinstOrganizationPolicyDocument(),
+			string(commons.OrganizationUserDataSourceName):           dataSourceSpotinstOrganizationUser(),
+			string(commons.OrganizationUsersDataSourceName):          dataSourceSpotinstOrganizationUsers(),
+			string(commons.OrganizationUserGroupDataSourceName):      dataSourceSpotinstOrganizationUserGroup(),
+			string(commons.OrganizationUserGroupsDataSourceName):     dataSourceSpotinstOrganizationUserGroups(),
+			string(commons.OrganizationPolicyDataSourceName):         dataSourceSpotinstOrganizationPolicy(),
+			string(commons.OrganizationPoliciesDataSourceName):       dataSourceSpotinstOrganizationPolicies(),
 		},
 	}
 
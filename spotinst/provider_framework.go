@@ -0,0 +1,129 @@
+package spotinst
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+// ProviderServerFactory returns a protocol version 5 server that serves the
+// SDKv2 resources of Provider() alongside the terraform-plugin-framework
+// resources of NewFrameworkProvider().
+func ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	servers := []func() tfprotov5.ProviderServer{
+		Provider().GRPCProvider,
+		providerserver.NewProtocol5(NewFrameworkProvider()),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// frameworkResources lists the resources implemented with
+// terraform-plugin-framework. A resource must be registered either here or
+// in Provider(), never in both.
+var frameworkResources = []func() resource.Resource{}
+
+// frameworkDataSources lists the data sources implemented with
+// terraform-plugin-framework.
+var frameworkDataSources = []func() datasource.DataSource{}
+
+// frameworkProvider is the terraform-plugin-framework half of the provider.
+// Its schema must match the schema of Provider(), since both are served by
+// the same muxed server.
+type frameworkProvider struct{}
+
+type frameworkProviderModel struct {
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Token        types.String `tfsdk:"token"`
+	Account      types.String `tfsdk:"account"`
+	FeatureFlags types.String `tfsdk:"feature_flags"`
+}
+
+// NewFrameworkProvider returns the terraform-plugin-framework provider.
+func NewFrameworkProvider() provider.Provider {
+	return &frameworkProvider{}
+}
+
+func (p *frameworkProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "spotinst"
+}
+
+func (p *frameworkProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			string(commons.ProviderEnabled): schema.BoolAttribute{
+				Optional:    true,
+				Description: "Enable or disable the Spotinst provider",
+			},
+
+			string(commons.ProviderToken): schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Spotinst Personal API Access Token",
+			},
+
+			string(commons.ProviderAccount): schema.StringAttribute{
+				Optional:    true,
+				Description: "Spotinst Account ID",
+			},
+
+			string(commons.ProviderFeatureFlags): schema.StringAttribute{
+				Optional:    true,
+				Description: "Spotinst SDK Feature Flags",
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var data frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config := Config{
+		Enabled:          data.Enabled.IsNull() || data.Enabled.ValueBool(),
+		Token:            data.Token.ValueString(),
+		Account:          data.Account.ValueString(),
+		FeatureFlags:     data.FeatureFlags.ValueString(),
+		terraformVersion: req.TerraformVersion,
+	}
+
+	// The SDKv2 provider already warns when the provider is disabled.
+	if !config.Enabled {
+		return
+	}
+
+	// The SDKv2 provider configures its client from the same configuration and
+	// already reports its diagnostics, which the mux server would otherwise
+	// show twice.
+	client, diags := config.Client()
+	if diags.HasError() {
+		return
+	}
+
+	resp.ResourceData = client
+	resp.DataSourceData = client
+}
+
+func (p *frameworkProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return frameworkResources
+}
+
+func (p *frameworkProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return frameworkDataSources
+}
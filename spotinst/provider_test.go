@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,6 +47,26 @@ func TestProvider_impl(t *testing.T) {
 	_ = Provider()
 }
 
+func TestProviderServer(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The muxed server fails to return a schema when the SDKv2 and the
+	// framework provider schemas differ.
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("err: %s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T, provider string) {
 	tokens := map[string]string{
 		"gcp":   os.Getenv("SPOTINST_TOKEN_GCP"),
@@ -0,0 +1,261 @@
+package spotinst
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/account/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/account_onboarding"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func resourceSpotinstAccountOnboarding() *schema.Resource {
+	setupAccountOnboardingResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstAccountOnboardingCreate,
+		ReadContext:   resourceSpotinstAccountOnboardingRead,
+		UpdateContext: resourceSpotinstAccountOnboardingUpdate,
+		DeleteContext: resourceSpotinstAccountOnboardingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSpotinstAccountOnboardingCustomizeDiff,
+
+		// Newly created IAM roles, service accounts and app registrations take
+		// a while to propagate, so attaching credentials is retried until
+		// the create or update timeout.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: commons.AccountOnboardingResource.GetSchemaMap(),
+	}
+}
+
+func setupAccountOnboardingResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	account_onboarding.Setup(fieldsMap)
+
+	commons.AccountOnboardingResource = commons.NewAccountOnboardingResource(fieldsMap)
+}
+
+func resourceSpotinstAccountOnboardingCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return account_onboarding.CustomizeDiff(diff)
+}
+
+func resourceSpotinstAccountOnboardingCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.AccountOnboardingResource.GetName())
+
+	onboarding, err := commons.AccountOnboardingResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, err := createAWSAccount(onboarding.Account, meta.(*Client))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resourceData.SetId(spotinst.StringValue(accountID))
+
+	if _, ok := resourceData.GetOk(string(account_onboarding.AWS)); ok {
+		if err := createAWSAccountExternalID(ctx, resourceData.Id(), meta.(*Client)); err != nil {
+			return rollbackAccountOnboarding(resourceData, meta, diag.FromErr(err))
+		}
+	}
+
+	timeout := resourceData.Timeout(schema.TimeoutCreate)
+	if diags := attachAccountOnboardingCredentials(ctx, resourceData.Id(), onboarding, meta.(*Client), timeout); diags.HasError() {
+		return rollbackAccountOnboarding(resourceData, meta, diags)
+	}
+
+	log.Printf("===> Account onboarded successfully: %s <===", resourceData.Id())
+	return resourceSpotinstAccountOnboardingRead(ctx, resourceData, meta)
+}
+
+// rollbackAccountOnboarding deletes an account whose onboarding failed, so
+// that a failed apply leaves nothing behind. If the account cannot be
+// deleted it stays in state, and Terraform replaces it on the next apply.
+func rollbackAccountOnboarding(resourceData *schema.ResourceData, meta interface{}, diags diag.Diagnostics) diag.Diagnostics {
+	log.Printf("===> Rolling back onboarding of account: %s <===", resourceData.Id())
+
+	if err := deleteAWSAccount(resourceData, meta); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to roll back onboarding of account %s", resourceData.Id()),
+			Detail:   err.Error(),
+		})
+	}
+	resourceData.SetId("")
+	return diags
+}
+
+func createAWSAccountExternalID(ctx context.Context, accountID string, spotinstClient *Client) error {
+	input := &aws.CreateAWSAccountExternalIdInput{AccountID: spotinst.String(accountID)}
+	if _, err := spotinstClient.account.CloudProviderAWS().CreateAWSAccountExternalId(ctx, input); err != nil {
+		return fmt.Errorf("[ERROR] failed to create external ID: %s", err)
+	}
+	return nil
+}
+
+// attachAccountOnboardingCredentials sets the credentials of the configured
+// cloud through the same code paths as the credentials resources. A new IAM
+// role cannot be assumed for a while after it is created, so credentials
+// rejected for that reason are retried until the timeout. Any other error is
+// returned right away.
+func attachAccountOnboardingCredentials(ctx context.Context, accountID string, onboarding *commons.OnboardedAccount,
+	spotinstClient *Client, timeout time.Duration) diag.Diagnostics {
+
+	var attach func() error
+	switch {
+	case onboarding.AWSCredentials != nil:
+		onboarding.AWSCredentials.SetAccountId(spotinst.String(accountID))
+		attach = func() error { return createAWSCredentials(onboarding.AWSCredentials, spotinstClient) }
+	case onboarding.AzureCredentials != nil:
+		onboarding.AzureCredentials.SetAccountId(spotinst.String(accountID))
+		attach = func() error { return createAzureCredentials(onboarding.AzureCredentials, spotinstClient) }
+	case onboarding.GCPCredentials != nil:
+		onboarding.GCPCredentials.SetAccountId(spotinst.String(accountID))
+		attach = func() error { return createGCPCredentials(onboarding.GCPCredentials, spotinstClient) }
+	default:
+		return nil
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		err := attach()
+		if err == nil {
+			return nil
+		}
+		if isRoleNotAssumable(err) {
+			log.Printf("===> Role not assumable yet, retrying: %s", err)
+			return resource.RetryableError(err)
+		}
+		return resource.NonRetryableError(err)
+	})
+	if err != nil {
+		return credentialsDiagnostics(err)
+	}
+	return nil
+}
+
+// isRoleNotAssumable reports whether the Spot API rejected credentials
+// because it could not assume their IAM role.
+func isRoleNotAssumable(err error) bool {
+	var errs client.Errors
+	if !errors.As(err, &errs) {
+		return false
+	}
+	for _, e := range errs {
+		message := strings.ToLower(e.Message)
+		if strings.Contains(message, "sts:assumerole") || strings.Contains(message, "assume role") {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceSpotinstAccountOnboardingRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.AccountOnboardingResource.GetName(), id)
+
+	spotinstClient := meta.(*Client)
+	output, err := spotinstClient.account.CloudProviderAWS().ReadAccount(ctx, &aws.ReadAccountInput{AccountID: spotinst.String(id)})
+	if err != nil {
+		// If the account was not found, return nil so that we can show
+		// that the account does not exist
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeAccountNotFound {
+					resourceData.SetId("")
+					return nil
+				}
+			}
+		}
+
+		// Some other error, report it.
+		return diag.Errorf("failed to read account: %s", err)
+	}
+
+	// if nothing was found, return no state
+	if output.Account == nil {
+		resourceData.SetId("")
+		return nil
+	}
+	onboarding := &commons.OnboardedAccount{Account: output.Account}
+
+	if _, ok := resourceData.GetOk(string(account_onboarding.AWS)); ok {
+		externalID, err := spotinstClient.account.CloudProviderAWS().ReadAWSAccountExternalId(ctx,
+			&aws.ReadAWSAccountExternalIdInput{AccountID: spotinst.String(id)})
+		if err != nil {
+			return diag.Errorf("failed to read external ID: %s", err)
+		}
+		if externalID.AwsAccountExternalId != nil {
+			onboarding.ExternalID = externalID.AwsAccountExternalId.ExternalId
+		}
+
+		if _, ok := resourceData.GetOk(fmt.Sprintf("%s.0.%s", account_onboarding.AWS, account_onboarding.IamRole)); ok {
+			credentials, err := spotinstClient.account.CloudProviderAWS().ReadCredentials(ctx,
+				&aws.ReadCredentialsInput{AccountId: spotinst.String(id)})
+			if err != nil {
+				return diag.Errorf("failed to read credentials: %s", err)
+			}
+			onboarding.AWSCredentials = credentials.Credentials
+		}
+	}
+
+	if err := commons.AccountOnboardingResource.OnRead(onboarding, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("===> Account onboarding read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstAccountOnboardingUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.AccountOnboardingResource.GetName(), id)
+
+	shouldUpdate, onboarding, err := commons.AccountOnboardingResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		timeout := resourceData.Timeout(schema.TimeoutUpdate)
+		if diags := attachAccountOnboardingCredentials(ctx, id, onboarding, meta.(*Client), timeout); diags.HasError() {
+			return diags
+		}
+	}
+
+	log.Printf("===> Account onboarding updated successfully: %s <===", id)
+	return resourceSpotinstAccountOnboardingRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstAccountOnboardingDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.AccountOnboardingResource.GetName(), id)
+
+	if err := deleteAWSAccount(resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Account deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
@@ -2,6 +2,7 @@ package spotinst
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 
@@ -50,7 +51,7 @@ func resourceSpotinstCredentialsAWSCreate(ctx context.Context, resourceData *sch
 
 	err = createAWSCredentials(credentials, meta.(*Client))
 	if err != nil {
-		return diag.FromErr(err)
+		return credentialsDiagnostics(err)
 	}
 
 	log.Printf("===> Account created successfully: %s <===", resourceData.Id())
@@ -67,7 +68,7 @@ func createAWSCredentials(credentials *aws.Credentials, spotinstClient *Client)
 	input := &aws.SetCredentialsInput{Credentials: credentials}
 	_, err := spotinstClient.account.CloudProviderAWS().Credentials(context.Background(), input)
 	if err != nil {
-		return fmt.Errorf("[ERROR] failed to set credential: %s", err)
+		return fmt.Errorf("[ERROR] failed to set credential: %w", err)
 	}
 	return nil
 }
@@ -104,3 +105,27 @@ func resourceSpotinstCredentialsAWSRead(ctx context.Context, resourceData *schem
 	log.Printf("===> Credentials read successfully: %s <===", id)
 	return nil
 }
+
+// credentialsDiagnostics reports each error the Spot API returns when it
+// rejects credentials as a diagnostic of its own, so that every problem it
+// found, such as a missing permission, is listed separately.
+func credentialsDiagnostics(err error) diag.Diagnostics {
+	var errs client.Errors
+	if !errors.As(err, &errs) || len(errs) == 0 {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, e := range errs {
+		detail := e.Message
+		if e.Field != "" {
+			detail = fmt.Sprintf("%s (field: %s)", detail, e.Field)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("failed to set credentials: %s", e.Code),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
@@ -50,7 +50,7 @@ func resourceSpotinstCredentialsAzureCreate(ctx context.Context, resourceData *s
 
 	err = createAzureCredentials(credentials, meta.(*Client))
 	if err != nil {
-		return diag.FromErr(err)
+		return credentialsDiagnostics(err)
 	}
 
 	log.Printf("===> Credentials set successfully: %s <===", resourceData.Id())
@@ -67,7 +67,7 @@ func createAzureCredentials(credentials *azure.Credentials, spotinstClient *Clie
 	input := &azure.SetCredentialsInput{Credentials: credentials}
 	_, err := spotinstClient.account.CloudProviderAzure().SetCredentials(context.Background(), input)
 	if err != nil {
-		return fmt.Errorf("[ERROR] failed to set credential: %s", err)
+		return fmt.Errorf("[ERROR] failed to set credential: %w", err)
 	}
 	return nil
 }
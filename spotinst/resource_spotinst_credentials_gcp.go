@@ -50,7 +50,7 @@ func resourceSpotinstCredentialsGCPCreate(ctx context.Context, resourceData *sch
 
 	err = createGCPCredentials(credentials, meta.(*Client))
 	if err != nil {
-		return diag.FromErr(err)
+		return credentialsDiagnostics(err)
 	}
 
 	log.Printf("===> Account credentials set successfully: %s <===", resourceData.Id())
@@ -67,7 +67,7 @@ func createGCPCredentials(credentials *gcp.ServiceAccounts, spotinstClient *Clie
 	input := &gcp.SetServiceAccountsInput{ServiceAccounts: credentials}
 	_, err := spotinstClient.account.CloudProviderGCP().SetServiceAccount(context.Background(), input)
 	if err != nil {
-		return fmt.Errorf("[ERROR] failed to set credential: %s", err)
+		return fmt.Errorf("[ERROR] failed to set credential: %w", err)
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package spotinst
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -44,6 +45,9 @@ func resourceSpotinstElastigroupAWS() *schema.Resource {
 		},
 
 		Schema: commons.ElastigroupResource.GetSchemaMap(),
+
+		SchemaVersion:  commons.ElastigroupResource.GetSchemaVersion(),
+		StateUpgraders: commons.ElastigroupResource.GetStateUpgraders(),
 	}
 }
 
@@ -790,3 +794,87 @@ func updateCapitalSlice(resourceData *schema.ResourceData, groupResponse *aws.Gr
 		}
 	}
 }
+
+// errGroupModifiedConcurrently is returned when a group was modified by
+// someone else between reading it and writing a partial update back.
+var errGroupModifiedConcurrently = errors.New("group was modified concurrently")
+
+// updateElastigroupAWSGroupPartially performs a read-modify-write of part of a
+// group, e.g. one of its scheduled tasks or scaling policies, on behalf of a
+// resource that does not own the whole group. build receives the current group
+// and returns the fields to update, or nil to skip the update. A group that no
+// longer exists is not an error.
+//
+// Changes to the same group are serialized within the provider process. Since
+// other processes, e.g. another Terraform run, can modify the group as well,
+// the group is read again right before the write, and the update is rebuilt
+// and retried when its UpdatedAt changed. The API has no conditional update,
+// so a change made between that last read and the write is still overwritten.
+func updateElastigroupAWSGroupPartially(ctx context.Context, groupID string, meta interface{},
+	build func(group *aws.Group) (*aws.Group, error)) error {
+
+	commons.ResourceMutexKV.Lock(groupID)
+	defer commons.ResourceMutexKV.Unlock(groupID)
+
+	return resource.RetryContext(ctx, time.Minute, func() *resource.RetryError {
+		group, err := readElastigroupAWSGroupIfExists(ctx, groupID, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if group == nil {
+			return nil
+		}
+
+		update, err := build(group)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if update == nil {
+			return nil
+		}
+		update.SetId(spotinst.String(groupID))
+
+		// Make sure nobody changed the group since we read it.
+		current, err := readElastigroupAWSGroupIfExists(ctx, groupID, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if current == nil {
+			return nil
+		}
+		if !spotinst.TimeValue(current.UpdatedAt).Equal(spotinst.TimeValue(group.UpdatedAt)) {
+			log.Printf("[WARN] Group %s was modified concurrently, retrying", groupID)
+			return resource.RetryableError(errGroupModifiedConcurrently)
+		}
+
+		if json, err := commons.ToJson(update); err != nil {
+			return resource.NonRetryableError(err)
+		} else {
+			log.Printf("===> Group partial update configuration: %s", json)
+		}
+
+		input := &aws.UpdateGroupInput{Group: update}
+		if _, err := meta.(*Client).elastigroup.CloudProviderAWS().Update(ctx, input); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// readElastigroupAWSGroupIfExists reads a group, returning nil if it does not
+// exist.
+func readElastigroupAWSGroupIfExists(ctx context.Context, groupID string, meta interface{}) (*aws.Group, error) {
+	input := &aws.ReadGroupInput{GroupID: spotinst.String(groupID)}
+	resp, err := meta.(*Client).elastigroup.CloudProviderAWS().Read(ctx, input)
+	if err != nil {
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeGroupNotFound {
+					return nil, nil
+				}
+			}
+		}
+		return nil, err
+	}
+	return resp.Group, nil
+}
@@ -0,0 +1,439 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_group_scaling_policy"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_scaling_policies"
+)
+
+// scalingPolicyIDSeparator separates the group ID and the policy name in a
+// scaling policy resource ID.
+const scalingPolicyIDSeparator = ":"
+
+func resourceSpotinstElastigroupAWSScalingPolicy() *schema.Resource {
+	setupElastigroupAWSScalingPolicyResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstElastigroupAWSScalingPolicyCreate,
+		ReadContext:   resourceSpotinstElastigroupAWSScalingPolicyRead,
+		UpdateContext: resourceSpotinstElastigroupAWSScalingPolicyUpdate,
+		DeleteContext: resourceSpotinstElastigroupAWSScalingPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSpotinstElastigroupAWSScalingPolicyImportState,
+		},
+
+		Schema: commons.ElastigroupAWSScalingPolicyResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSScalingPolicyResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_group_scaling_policy.Setup(fieldsMap)
+
+	commons.ElastigroupAWSScalingPolicyResource = commons.NewElastigroupAWSScalingPolicyResource(fieldsMap)
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ElastigroupAWSScalingPolicyResource.GetName())
+
+	desired, err := commons.ElastigroupAWSScalingPolicyResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := resourceData.Get(string(elastigroup_aws_group_scaling_policy.GroupID)).(string)
+	name, err := elastigroupAWSScalingPolicyName(desired)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateElastigroupAWSGroupPartially(ctx, groupID, meta, func(group *aws.Group) (*aws.Group, error) {
+		if _, policy := findElastigroupAWSScalingPolicy(group.Scaling, name); policy != nil {
+			return nil, fmt.Errorf("scaling policy %q already exists in group %s, import it instead", name, groupID)
+		}
+		return mergeElastigroupAWSScalingPolicy(group.Scaling, "", nil, desired), nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to create scaling policy: %s", err)
+	}
+
+	resourceData.SetId(groupID + scalingPolicyIDSeparator + name)
+	log.Printf("===> Scaling policy created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstElastigroupAWSScalingPolicyRead(ctx, resourceData, meta)
+}
+
+// resourceSpotinstElastigroupAWSScalingPolicyImportState imports a policy
+// together with the multiple metrics it uses, which Read only keeps when they
+// are already in the state.
+func resourceSpotinstElastigroupAWSScalingPolicyImportState(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	groupID, name, err := parseScalingPolicyID(resourceData.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := readElastigroupAWSGroupIfExists(ctx, groupID, meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read group: %s", err)
+	}
+	if group == nil {
+		return nil, fmt.Errorf("group %s does not exist", groupID)
+	}
+
+	_, policy := findElastigroupAWSScalingPolicy(group.Scaling, name)
+	if policy == nil {
+		return nil, fmt.Errorf("scaling policy %q does not exist in group %s", name, groupID)
+	}
+
+	if group.Scaling.MultipleMetrics != nil {
+		owned := elastigroupAWSScalingPolicyMultipleMetricsNames(policy, group.Scaling.MultipleMetrics)
+		scaling := &aws.Scaling{
+			MultipleMetrics: filterElastigroupAWSMultipleMetrics(group.Scaling.MultipleMetrics, func(name string) bool {
+				return owned[name]
+			}),
+		}
+		if scaling.MultipleMetrics != nil {
+			if err := commons.ElastigroupAWSScalingPolicyResource.OnRead(scaling, resourceData, meta); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSScalingPolicyResource.GetName(), id)
+
+	groupID, name, err := parseScalingPolicyID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := readElastigroupAWSGroupIfExists(ctx, groupID, meta)
+	if err != nil {
+		return diag.Errorf("failed to read group: %s", err)
+	}
+
+	// If the group is gone, so is the policy.
+	if group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	kind, policy := findElastigroupAWSScalingPolicy(group.Scaling, name)
+	if policy == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	scaling := &aws.Scaling{}
+	switch kind {
+	case elastigroup_aws_group_scaling_policy.ScalingUpPolicy:
+		scaling.Up = []*aws.ScalingPolicy{policy}
+	case elastigroup_aws_group_scaling_policy.ScalingDownPolicy:
+		scaling.Down = []*aws.ScalingPolicy{policy}
+	case elastigroup_aws_group_scaling_policy.ScalingTargetPolicy:
+		scaling.Target = []*aws.ScalingPolicy{policy}
+	}
+
+	// Only the metrics and expressions this resource declared are its own;
+	// the rest of the group's multiple metrics belong to other policies.
+	if group.Scaling != nil && group.Scaling.MultipleMetrics != nil {
+		owned := elastigroupAWSMultipleMetricsNames(resourceData.Get(string(elastigroup_aws_group_scaling_policy.MultipleMetrics)))
+		scaling.MultipleMetrics = filterElastigroupAWSMultipleMetrics(group.Scaling.MultipleMetrics, func(name string) bool {
+			return owned[name]
+		})
+	}
+
+	if err := resourceData.Set(string(elastigroup_aws_group_scaling_policy.GroupID), groupID); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(elastigroup_aws_group_scaling_policy.GroupID), err)
+	}
+
+	if err := commons.ElastigroupAWSScalingPolicyResource.OnRead(scaling, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Scaling policy read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.ElastigroupAWSScalingPolicyResource.GetName(), id)
+
+	groupID, oldName, err := parseScalingPolicyID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The policy is always written as a whole, so expand the desired state
+	// the same way as on create.
+	desired, err := commons.ElastigroupAWSScalingPolicyResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name, err := elastigroupAWSScalingPolicyName(desired)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldMetrics, _ := resourceData.GetChange(string(elastigroup_aws_group_scaling_policy.MultipleMetrics))
+	owned := elastigroupAWSMultipleMetricsNames(oldMetrics)
+
+	err = updateElastigroupAWSGroupPartially(ctx, groupID, meta, func(group *aws.Group) (*aws.Group, error) {
+		if name != oldName {
+			if _, policy := findElastigroupAWSScalingPolicy(group.Scaling, name); policy != nil {
+				return nil, fmt.Errorf("scaling policy %q already exists in group %s", name, groupID)
+			}
+		}
+		return mergeElastigroupAWSScalingPolicy(group.Scaling, oldName, owned, desired), nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to update scaling policy: %s", err)
+	}
+
+	resourceData.SetId(groupID + scalingPolicyIDSeparator + name)
+	log.Printf("===> Scaling policy updated successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstElastigroupAWSScalingPolicyRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScalingPolicyDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.ElastigroupAWSScalingPolicyResource.GetName(), id)
+
+	groupID, name, err := parseScalingPolicyID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	owned := elastigroupAWSMultipleMetricsNames(resourceData.Get(string(elastigroup_aws_group_scaling_policy.MultipleMetrics)))
+
+	err = updateElastigroupAWSGroupPartially(ctx, groupID, meta, func(group *aws.Group) (*aws.Group, error) {
+		if _, policy := findElastigroupAWSScalingPolicy(group.Scaling, name); policy == nil && len(owned) == 0 {
+			return nil, nil
+		}
+		return mergeElastigroupAWSScalingPolicy(group.Scaling, name, owned, nil), nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to delete scaling policy: %s", err)
+	}
+
+	log.Printf("===> Scaling policy deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+// mergeElastigroupAWSScalingPolicy returns a group update holding the scaling
+// configuration of the group with the policy named oldName and the multiple
+// metrics entries named in owned replaced by those of desired. A nil desired
+// only removes them.
+func mergeElastigroupAWSScalingPolicy(current *aws.Scaling, oldName string, owned map[string]bool, desired *aws.Scaling) *aws.Group {
+	if current == nil {
+		current = &aws.Scaling{}
+	}
+	if desired == nil {
+		desired = &aws.Scaling{}
+	}
+
+	merge := func(policies, add []*aws.ScalingPolicy) []*aws.ScalingPolicy {
+		result := make([]*aws.ScalingPolicy, 0, len(policies)+len(add))
+		for _, policy := range policies {
+			if oldName == "" || spotinst.StringValue(policy.PolicyName) != oldName {
+				result = append(result, policy)
+			}
+		}
+		result = append(result, add...)
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	}
+
+	scaling := &aws.Scaling{}
+	scaling.SetUp(merge(current.Up, desired.Up))
+	scaling.SetDown(merge(current.Down, desired.Down))
+	scaling.SetTarget(merge(current.Target, desired.Target))
+
+	if current.MultipleMetrics != nil || desired.MultipleMetrics != nil {
+		replaced := make(map[string]bool, len(owned))
+		for name := range owned {
+			replaced[name] = true
+		}
+		if desired.MultipleMetrics != nil {
+			for name := range multipleMetricsNameSet(desired.MultipleMetrics) {
+				replaced[name] = true
+			}
+		}
+
+		multipleMetrics := &aws.MultipleMetrics{}
+		if current.MultipleMetrics != nil {
+			if kept := filterElastigroupAWSMultipleMetrics(current.MultipleMetrics, func(name string) bool {
+				return !replaced[name]
+			}); kept != nil {
+				multipleMetrics = kept
+			}
+		}
+		if desired.MultipleMetrics != nil {
+			multipleMetrics.Metrics = append(multipleMetrics.Metrics, desired.MultipleMetrics.Metrics...)
+			multipleMetrics.Expressions = append(multipleMetrics.Expressions, desired.MultipleMetrics.Expressions...)
+		}
+
+		if len(multipleMetrics.Metrics) > 0 || len(multipleMetrics.Expressions) > 0 {
+			scaling.SetMultipleMetrics(multipleMetrics)
+		} else {
+			scaling.SetMultipleMetrics(nil)
+		}
+	}
+
+	update := &aws.Group{}
+	update.SetScaling(scaling)
+	return update
+}
+
+// findElastigroupAWSScalingPolicy looks up a policy of a group by name and
+// returns the field of the policy kind it was found in.
+func findElastigroupAWSScalingPolicy(scaling *aws.Scaling, name string) (commons.FieldName, *aws.ScalingPolicy) {
+	if scaling == nil {
+		return "", nil
+	}
+
+	kinds := []struct {
+		field    commons.FieldName
+		policies []*aws.ScalingPolicy
+	}{
+		{elastigroup_aws_group_scaling_policy.ScalingUpPolicy, scaling.Up},
+		{elastigroup_aws_group_scaling_policy.ScalingDownPolicy, scaling.Down},
+		{elastigroup_aws_group_scaling_policy.ScalingTargetPolicy, scaling.Target},
+	}
+	for _, kind := range kinds {
+		for _, policy := range kind.policies {
+			if spotinst.StringValue(policy.PolicyName) == name {
+				return kind.field, policy
+			}
+		}
+	}
+	return "", nil
+}
+
+// elastigroupAWSScalingPolicyName returns the name of the single policy the
+// resource declares.
+func elastigroupAWSScalingPolicyName(scaling *aws.Scaling) (string, error) {
+	var policies []*aws.ScalingPolicy
+	policies = append(policies, scaling.Up...)
+	policies = append(policies, scaling.Down...)
+	policies = append(policies, scaling.Target...)
+
+	if len(policies) != 1 || spotinst.StringValue(policies[0].PolicyName) == "" {
+		return "", fmt.Errorf("exactly one of %q, %q or %q with a %q must be set",
+			elastigroup_aws_group_scaling_policy.ScalingUpPolicy,
+			elastigroup_aws_group_scaling_policy.ScalingDownPolicy,
+			elastigroup_aws_group_scaling_policy.ScalingTargetPolicy,
+			elastigroup_aws_group_scaling_policy.PolicyName)
+	}
+	return spotinst.StringValue(policies[0].PolicyName), nil
+}
+
+// elastigroupAWSScalingPolicyMultipleMetricsNames returns the names of the
+// metrics and expressions of multipleMetrics that policy uses: the one named
+// by its metric name and, for expressions, those the expression refers to.
+func elastigroupAWSScalingPolicyMultipleMetricsNames(policy *aws.ScalingPolicy, multipleMetrics *aws.MultipleMetrics) map[string]bool {
+	expressions := make(map[string]string, len(multipleMetrics.Expressions))
+	for _, expression := range multipleMetrics.Expressions {
+		expressions[spotinst.StringValue(expression.Name)] = spotinst.StringValue(expression.Expression)
+	}
+	all := multipleMetricsNameSet(multipleMetrics)
+
+	names := make(map[string]bool)
+	pending := []string{spotinst.StringValue(policy.MetricName)}
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		if names[name] || !all[name] {
+			continue
+		}
+		names[name] = true
+		if expression, ok := expressions[name]; ok {
+			pending = append(pending, multipleMetricsIdentifierRegexp.FindAllString(expression, -1)...)
+		}
+	}
+	return names
+}
+
+// multipleMetricsIdentifierRegexp matches the names an expression of multiple
+// metrics can refer to.
+var multipleMetricsIdentifierRegexp = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// elastigroupAWSMultipleMetricsNames returns the names of the metrics and
+// expressions of a multiple_metrics block.
+func elastigroupAWSMultipleMetricsNames(data interface{}) map[string]bool {
+	if set, ok := data.(*schema.Set); !ok || set.Len() == 0 {
+		return nil
+	}
+	multipleMetrics, err := elastigroup_aws_scaling_policies.ExpandMultipleMetrics(data)
+	if err != nil || multipleMetrics == nil {
+		return nil
+	}
+	return multipleMetricsNameSet(multipleMetrics)
+}
+
+func multipleMetricsNameSet(multipleMetrics *aws.MultipleMetrics) map[string]bool {
+	names := make(map[string]bool)
+	for _, metric := range multipleMetrics.Metrics {
+		names[spotinst.StringValue(metric.Name)] = true
+	}
+	for _, expression := range multipleMetrics.Expressions {
+		names[spotinst.StringValue(expression.Name)] = true
+	}
+	return names
+}
+
+// filterElastigroupAWSMultipleMetrics returns the metrics and expressions
+// whose names satisfy keep, or nil if there are none.
+func filterElastigroupAWSMultipleMetrics(multipleMetrics *aws.MultipleMetrics, keep func(name string) bool) *aws.MultipleMetrics {
+	result := &aws.MultipleMetrics{}
+	for _, metric := range multipleMetrics.Metrics {
+		if keep(spotinst.StringValue(metric.Name)) {
+			result.Metrics = append(result.Metrics, metric)
+		}
+	}
+	for _, expression := range multipleMetrics.Expressions {
+		if keep(spotinst.StringValue(expression.Name)) {
+			result.Expressions = append(result.Expressions, expression)
+		}
+	}
+	if len(result.Metrics) == 0 && len(result.Expressions) == 0 {
+		return nil
+	}
+	return result
+}
+
+// parseScalingPolicyID splits a scaling policy resource ID into the group ID
+// and the policy name.
+func parseScalingPolicyID(id string) (groupID, name string, err error) {
+	parts := strings.SplitN(id, scalingPolicyIDSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <group_id>%s<policy_name>",
+			id, scalingPolicyIDSeparator)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,356 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/credentials"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/session"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupAWSScalingPolicyResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSScalingPolicyResourceName), name)
+}
+
+func testElastigroupAWSScalingPolicyDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.ElastigroupAWSScalingPolicyResourceName) {
+			continue
+		}
+		groupID, name, err := parseScalingPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		group, err := readElastigroupAWSGroupIfExists(context.Background(), groupID, client)
+		if err != nil || group == nil {
+			continue
+		}
+		if _, policy := findElastigroupAWSScalingPolicy(group.Scaling, name); policy != nil {
+			return fmt.Errorf("scaling policy still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckElastigroupAWSScalingPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		groupID, name, err := parseScalingPolicyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		group, err := readElastigroupAWSGroupIfExists(context.Background(), groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("group not found: %s", groupID)
+		}
+		if _, policy := findElastigroupAWSScalingPolicy(group.Scaling, name); policy == nil {
+			return fmt.Errorf("scaling policy not found: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+type ElastigroupAWSScalingPolicyMetadata struct {
+	provider             string
+	name                 string
+	groupID              string
+	updateBaselineFields bool
+}
+
+func createElastigroupAWSScalingPolicyTerraform(ccm *ElastigroupAWSScalingPolicyMetadata) string {
+	if ccm == nil {
+		return ""
+	}
+
+	if ccm.provider == "" {
+		ccm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	format := testBaselineElastigroupAWSScalingPolicyConfig_Create
+	if ccm.updateBaselineFields {
+		format = testBaselineElastigroupAWSScalingPolicyConfig_Update
+	}
+	template += fmt.Sprintf(format,
+		ccm.name,
+		ccm.provider,
+		ccm.groupID,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", ccm.name, template)
+	return template
+}
+
+// region ElastigroupAWSScalingPolicy: Baseline
+func TestAccSpotinstElastigroupAWSScalingPolicy_Baseline(t *testing.T) {
+	name := "test-acc-eg-scaling-policy"
+	groupID := "sig-74267bfd"
+	resourceName := createElastigroupAWSScalingPolicyResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSScalingPolicyDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSScalingPolicyTerraform(&ElastigroupAWSScalingPolicyMetadata{
+					name:    name,
+					groupID: groupID,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScalingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", groupID+":cpu-target"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "scaling_target_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_up_policy.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_down_policy.#", "0"),
+				),
+			},
+			{
+				Config: createElastigroupAWSScalingPolicyTerraform(&ElastigroupAWSScalingPolicyMetadata{
+					name:                 name,
+					groupID:              groupID,
+					updateBaselineFields: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScalingPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", groupID+":cpu-target"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_target_policy.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testBaselineElastigroupAWSScalingPolicyConfig_Create = `
+resource "` + string(commons.ElastigroupAWSScalingPolicyResourceName) + `" "%v" {
+  provider = "%v"
+
+  group_id = "%v"
+
+  scaling_target_policy {
+    policy_name = "cpu-target"
+    metric_name = "CPUUtilization"
+    namespace   = "AWS/EC2"
+    source      = "cloudWatch"
+    statistic   = "average"
+    unit        = "percent"
+    cooldown    = 60
+    target      = 50
+  }
+}
+`
+
+const testBaselineElastigroupAWSScalingPolicyConfig_Update = `
+resource "` + string(commons.ElastigroupAWSScalingPolicyResourceName) + `" "%v" {
+  provider = "%v"
+
+  group_id = "%v"
+
+  scaling_target_policy {
+    policy_name = "cpu-target"
+    metric_name = "CPUUtilization"
+    namespace   = "AWS/EC2"
+    source      = "cloudWatch"
+    statistic   = "average"
+    unit        = "percent"
+    cooldown    = 120
+    target      = 70
+  }
+}
+`
+
+// endregion
+
+// region ElastigroupAWSScalingPolicy: Import
+
+// testElastigroupAWSScalingPolicyGroup is a group whose up policy uses an
+// expression of multiple metrics and whose target policy uses a plain metric.
+const testElastigroupAWSScalingPolicyGroup = `{
+  "id": "sig-12345678",
+  "scaling": {
+    "up": [{"policyName": "queue-depth", "metricName": "backlog_per_instance", "namespace": "Custom", "source": "spectrum",
+            "statistic": "average", "unit": "count", "threshold": 100, "operator": "gte", "evaluationPeriods": 2, "period": 60,
+            "action": {"type": "adjustment", "adjustment": "2"}}],
+    "target": [{"policyName": "cpu-target", "metricName": "CPUUtilization", "namespace": "AWS/EC2", "source": "cloudWatch",
+                "statistic": "average", "unit": "percent", "target": 50}],
+    "multipleMetrics": {
+      "metrics": [
+        {"name": "visible", "metricName": "ApproximateNumberOfMessagesVisible", "namespace": "AWS/SQS", "statistic": "sum"},
+        {"name": "in_flight", "metricName": "ApproximateNumberOfMessagesNotVisible", "namespace": "AWS/SQS", "statistic": "sum"},
+        {"name": "errors", "metricName": "Errors", "namespace": "Custom", "statistic": "sum"}
+      ],
+      "expressions": [
+        {"name": "backlog", "expression": "visible + in_flight"},
+        {"name": "backlog_per_instance", "expression": "backlog / 3"},
+        {"name": "error_rate", "expression": "errors / 60"}
+      ]
+    }
+  }
+}`
+
+func testElastigroupAWSScalingPolicyClient(t *testing.T, group string) *Client {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/aws/ec2/group/sig-12345678" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"response":{"errors":[{"code":"BAD_REQUEST","message":"unexpected request"}]}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"response":{"status":{"code":200},"items":[%s]}}`, group)
+	}))
+	t.Cleanup(server.Close)
+
+	config := spotinst.DefaultConfig().
+		WithBaseURL(server.URL).
+		WithCredentials(credentials.NewStaticCredentials("fake", "act-12345678"))
+	return &Client{elastigroup: elastigroup.New(session.New(config))}
+}
+
+func TestElastigroupAWSScalingPolicyImportState(t *testing.T) {
+	cases := []struct {
+		name            string
+		id              string
+		multipleMetrics bool
+		err             string
+	}{
+		{
+			name:            "expression",
+			id:              "sig-12345678:queue-depth",
+			multipleMetrics: true,
+		},
+		{
+			name: "plain metric",
+			id:   "sig-12345678:cpu-target",
+		},
+		{
+			name: "unknown policy",
+			id:   "sig-12345678:memory",
+			err:  `scaling policy "memory" does not exist in group sig-12345678`,
+		},
+		{
+			name: "invalid ID",
+			id:   "sig-12345678",
+			err:  "unexpected format of ID",
+		},
+	}
+
+	meta := testElastigroupAWSScalingPolicyClient(t, testElastigroupAWSScalingPolicyGroup)
+	res := resourceSpotinstElastigroupAWSScalingPolicy()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resourceData := res.Data(nil)
+			resourceData.SetId(c.id)
+
+			imported, err := res.Importer.StateContext(context.Background(), resourceData, meta)
+			if c.err != "" {
+				if err == nil || !strings.Contains(err.Error(), c.err) {
+					t.Errorf("expected error containing %q, got %v", c.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(imported) != 1 {
+				t.Fatalf("expected 1 resource, got %d", len(imported))
+			}
+
+			owned := elastigroupAWSMultipleMetricsNames(imported[0].Get("multiple_metrics"))
+			if !c.multipleMetrics {
+				if len(owned) != 0 {
+					t.Errorf("expected no multiple metrics, got %v", owned)
+				}
+				return
+			}
+			for _, name := range []string{"visible", "in_flight", "backlog", "backlog_per_instance"} {
+				if !owned[name] {
+					t.Errorf("expected %q to be imported, got %v", name, owned)
+				}
+			}
+			for _, name := range []string{"errors", "error_rate"} {
+				if owned[name] {
+					t.Errorf("expected %q, which another policy uses, not to be imported", name)
+				}
+			}
+
+		})
+	}
+}
+
+func TestElastigroupAWSScalingPolicyMultipleMetricsNames(t *testing.T) {
+	multipleMetrics := &aws.MultipleMetrics{
+		Metrics: []*aws.Metrics{
+			{Name: spotinst.String("a")},
+			{Name: spotinst.String("b")},
+			{Name: spotinst.String("c")},
+		},
+		Expressions: []*aws.Expressions{
+			{Name: spotinst.String("sum"), Expression: spotinst.String("a + b")},
+			{Name: spotinst.String("ratio"), Expression: spotinst.String("sum / 2")},
+			{Name: spotinst.String("loop"), Expression: spotinst.String("loop + c")},
+		},
+	}
+
+	cases := []struct {
+		metricName string
+		expected   []string
+	}{
+		{"ratio", []string{"a", "b", "ratio", "sum"}},
+		{"sum", []string{"a", "b", "sum"}},
+		{"c", []string{"c"}},
+		{"loop", []string{"c", "loop"}},
+		{"CPUUtilization", nil},
+		{"", nil},
+	}
+	for _, c := range cases {
+		t.Run(c.metricName, func(t *testing.T) {
+			names := elastigroupAWSScalingPolicyMultipleMetricsNames(&aws.ScalingPolicy{MetricName: spotinst.String(c.metricName)}, multipleMetrics)
+			var got []string
+			for name := range names {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(c.expected, ",") {
+				t.Errorf("expected %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+// endregion
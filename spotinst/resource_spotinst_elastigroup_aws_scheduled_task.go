@@ -0,0 +1,260 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/elastigroup_aws_group_scheduled_task"
+)
+
+// scheduledTaskIDSeparator separates the parts of a scheduled task resource
+// ID: `<group or cluster id>:<task type>:<cron expression or frequency>`.
+const scheduledTaskIDSeparator = ":"
+
+func resourceSpotinstElastigroupAWSScheduledTask() *schema.Resource {
+	setupElastigroupAWSScheduledTaskResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstElastigroupAWSScheduledTaskCreate,
+		ReadContext:   resourceSpotinstElastigroupAWSScheduledTaskRead,
+		UpdateContext: resourceSpotinstElastigroupAWSScheduledTaskUpdate,
+		DeleteContext: resourceSpotinstElastigroupAWSScheduledTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commons.ElastigroupAWSScheduledTaskResource.GetSchemaMap(),
+	}
+}
+
+func setupElastigroupAWSScheduledTaskResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	elastigroup_aws_group_scheduled_task.Setup(fieldsMap)
+
+	commons.ElastigroupAWSScheduledTaskResource = commons.NewElastigroupAWSScheduledTaskResource(fieldsMap)
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.ElastigroupAWSScheduledTaskResource.GetName())
+
+	task, err := commons.ElastigroupAWSScheduledTaskResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := resourceData.Get(string(elastigroup_aws_group_scheduled_task.GroupID)).(string)
+	key := elastigroupAWSScheduledTaskKey(task)
+	if key == "" {
+		return diag.Errorf("one of %q or %q must be set",
+			elastigroup_aws_group_scheduled_task.CronExpression, elastigroup_aws_group_scheduled_task.Frequency)
+	}
+
+	if json, err := commons.ToJson(task); err != nil {
+		return diag.FromErr(err)
+	} else {
+		log.Printf("===> Scheduled task create configuration: %s", json)
+	}
+
+	err = updateElastigroupAWSScheduledTasks(ctx, groupID, meta, func(tasks []*aws.Task) ([]*aws.Task, error) {
+		if matches := findElastigroupAWSScheduledTasks(tasks, key); len(matches) > 0 {
+			return nil, fmt.Errorf("scheduled task %q already exists in group %s, import it instead", key, groupID)
+		}
+		return append(tasks, task), nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to create scheduled task: %s", err)
+	}
+
+	resourceData.SetId(groupID + scheduledTaskIDSeparator + key)
+	log.Printf("===> Scheduled task created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstElastigroupAWSScheduledTaskRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.ElastigroupAWSScheduledTaskResource.GetName(), id)
+
+	groupID, key, err := parseScheduledTaskID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := readElastigroupAWSGroupIfExists(ctx, groupID, meta)
+	if err != nil {
+		return diag.Errorf("failed to read group: %s", err)
+	}
+
+	// If the group is gone, so is the task.
+	if group == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	var tasks []*aws.Task
+	if group.Scheduling != nil {
+		tasks = group.Scheduling.Tasks
+	}
+
+	matches := findElastigroupAWSScheduledTasks(tasks, key)
+	switch len(matches) {
+	case 0:
+		resourceData.SetId("")
+		return nil
+	case 1:
+	default:
+		return diag.Errorf("found %d scheduled tasks matching %q in group %s", len(matches), key, groupID)
+	}
+
+	if err := resourceData.Set(string(elastigroup_aws_group_scheduled_task.GroupID), groupID); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(elastigroup_aws_group_scheduled_task.GroupID), err)
+	}
+
+	if err := commons.ElastigroupAWSScheduledTaskResource.OnRead(tasks[matches[0]], resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Scheduled task read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.ElastigroupAWSScheduledTaskResource.GetName(), id)
+
+	groupID, key, err := parseScheduledTaskID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateElastigroupAWSScheduledTasks(ctx, groupID, meta, func(tasks []*aws.Task) ([]*aws.Task, error) {
+		matches := findElastigroupAWSScheduledTasks(tasks, key)
+		if len(matches) != 1 {
+			return nil, fmt.Errorf("found %d scheduled tasks matching %q in group %s", len(matches), key, groupID)
+		}
+
+		shouldUpdate, err := commons.ElastigroupAWSScheduledTaskResource.OnUpdate(tasks[matches[0]], resourceData, meta)
+		if err != nil {
+			return nil, err
+		}
+		if !shouldUpdate {
+			return nil, nil
+		}
+		return tasks, nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to update scheduled task: %s", err)
+	}
+
+	log.Printf("===> Scheduled task updated successfully: %s <===", id)
+	return resourceSpotinstElastigroupAWSScheduledTaskRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstElastigroupAWSScheduledTaskDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.ElastigroupAWSScheduledTaskResource.GetName(), id)
+
+	groupID, key, err := parseScheduledTaskID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateElastigroupAWSScheduledTasks(ctx, groupID, meta, func(tasks []*aws.Task) ([]*aws.Task, error) {
+		matches := findElastigroupAWSScheduledTasks(tasks, key)
+		if len(matches) == 0 {
+			return nil, nil
+		}
+
+		result := make([]*aws.Task, 0, len(tasks))
+		for i, task := range tasks {
+			if i != matches[0] {
+				result = append(result, task)
+			}
+		}
+		return result, nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to delete scheduled task: %s", err)
+	}
+
+	log.Printf("===> Scheduled task deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+// updateElastigroupAWSScheduledTasks applies mutate to the scheduled tasks of
+// a group and writes them back. When mutate returns nil tasks and no error,
+// nothing is written. A group that no longer exists is not an error.
+func updateElastigroupAWSScheduledTasks(ctx context.Context, groupID string, meta interface{},
+	mutate func(tasks []*aws.Task) ([]*aws.Task, error)) error {
+
+	return updateElastigroupAWSGroupPartially(ctx, groupID, meta, func(group *aws.Group) (*aws.Group, error) {
+		var tasks []*aws.Task
+		if group.Scheduling != nil {
+			tasks = group.Scheduling.Tasks
+		}
+
+		tasks, err := mutate(tasks)
+		if err != nil || tasks == nil {
+			return nil, err
+		}
+
+		scheduling := &aws.Scheduling{}
+		if len(tasks) > 0 {
+			scheduling.SetTasks(tasks)
+		} else {
+			scheduling.SetTasks(nil)
+		}
+
+		update := &aws.Group{}
+		update.SetScheduling(scheduling)
+		return update, nil
+	})
+}
+
+// elastigroupAWSScheduledTaskKey identifies a task within its group by type
+// and schedule, since tasks have no ID of their own.
+func elastigroupAWSScheduledTaskKey(task *aws.Task) string {
+	schedule := spotinst.StringValue(task.CronExpression)
+	if schedule == "" {
+		schedule = spotinst.StringValue(task.Frequency)
+	}
+	if schedule == "" {
+		return ""
+	}
+	return spotinst.StringValue(task.Type) + scheduledTaskIDSeparator + schedule
+}
+
+func findElastigroupAWSScheduledTasks(tasks []*aws.Task, key string) []int {
+	var matches []int
+	for i, task := range tasks {
+		if elastigroupAWSScheduledTaskKey(task) == key {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// parseScheduledTaskID splits a scheduled task resource ID into the parent
+// group or cluster ID and the task key.
+func parseScheduledTaskID(id string) (parentID, key string, err error) {
+	parts := strings.SplitN(id, scheduledTaskIDSeparator, 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <parent_id>%s<task_type>%s<schedule>",
+			id, scheduledTaskIDSeparator, scheduledTaskIDSeparator)
+	}
+	return parts[0], parts[1] + scheduledTaskIDSeparator + parts[2], nil
+}
@@ -0,0 +1,181 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createElastigroupAWSScheduledTaskResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.ElastigroupAWSScheduledTaskResourceName), name)
+}
+
+func testElastigroupAWSScheduledTaskDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.ElastigroupAWSScheduledTaskResourceName) {
+			continue
+		}
+		groupID, key, err := parseScheduledTaskID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		group, err := readElastigroupAWSGroupIfExists(context.Background(), groupID, client)
+		if err != nil || group == nil || group.Scheduling == nil {
+			continue
+		}
+		if len(findElastigroupAWSScheduledTasks(group.Scheduling.Tasks, key)) > 0 {
+			return fmt.Errorf("scheduled task still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckElastigroupAWSScheduledTaskExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		groupID, key, err := parseScheduledTaskID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		group, err := readElastigroupAWSGroupIfExists(context.Background(), groupID, client)
+		if err != nil {
+			return err
+		}
+		if group == nil || group.Scheduling == nil ||
+			len(findElastigroupAWSScheduledTasks(group.Scheduling.Tasks, key)) != 1 {
+			return fmt.Errorf("scheduled task not found: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+type ElastigroupAWSScheduledTaskMetadata struct {
+	provider             string
+	name                 string
+	groupID              string
+	updateBaselineFields bool
+}
+
+func createElastigroupAWSScheduledTaskTerraform(ccm *ElastigroupAWSScheduledTaskMetadata) string {
+	if ccm == nil {
+		return ""
+	}
+
+	if ccm.provider == "" {
+		ccm.provider = "aws"
+	}
+
+	template :=
+		`provider "aws" {
+	 token   = "fake"
+	 account = "fake"
+	}
+	`
+
+	format := testBaselineElastigroupAWSScheduledTaskConfig_Create
+	if ccm.updateBaselineFields {
+		format = testBaselineElastigroupAWSScheduledTaskConfig_Update
+	}
+	template += fmt.Sprintf(format,
+		ccm.name,
+		ccm.provider,
+		ccm.groupID,
+	)
+
+	log.Printf("Terraform [%v] template:\n%v", ccm.name, template)
+	return template
+}
+
+// region ElastigroupAWSScheduledTask: Baseline
+func TestAccSpotinstElastigroupAWSScheduledTask_Baseline(t *testing.T) {
+	name := "test-acc-eg-scheduled-task"
+	groupID := "sig-74267bfd"
+	resourceName := createElastigroupAWSScheduledTaskResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testElastigroupAWSScheduledTaskDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createElastigroupAWSScheduledTaskTerraform(&ElastigroupAWSScheduledTaskMetadata{
+					name:    name,
+					groupID: groupID,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScheduledTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", groupID+":scale:0 22 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "group_id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "task_type", "scale"),
+					resource.TestCheckResourceAttr(resourceName, "cron_expression", "0 22 * * *"),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "scale_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale_min_capacity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scale_max_capacity", "2"),
+				),
+			},
+			{
+				Config: createElastigroupAWSScheduledTaskTerraform(&ElastigroupAWSScheduledTaskMetadata{
+					name:                 name,
+					groupID:              groupID,
+					updateBaselineFields: true,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupAWSScheduledTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scale_target_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scale_min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scale_max_capacity", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testBaselineElastigroupAWSScheduledTaskConfig_Create = `
+resource "` + string(commons.ElastigroupAWSScheduledTaskResourceName) + `" "%v" {
+  provider = "%v"
+
+  group_id              = "%v"
+  task_type             = "scale"
+  cron_expression       = "0 22 * * *"
+  scale_target_capacity = 1
+  scale_min_capacity    = 0
+  scale_max_capacity    = 2
+}
+`
+
+const testBaselineElastigroupAWSScheduledTaskConfig_Update = `
+resource "` + string(commons.ElastigroupAWSScheduledTaskResourceName) + `" "%v" {
+  provider = "%v"
+
+  group_id              = "%v"
+  task_type             = "scale"
+  cron_expression       = "0 22 * * *"
+  is_enabled            = false
+  scale_target_capacity = 2
+  scale_min_capacity    = 1
+  scale_max_capacity    = 3
+}
+`
+
+// endregion
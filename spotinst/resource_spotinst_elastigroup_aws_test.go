@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -4707,3 +4708,68 @@ const testElastigroupLoggingAWSConfig_EmptyFields = `
 `
 
 // endregion
+
+// region State upgrade
+
+func TestElastigroupAWSStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		expected interface{}
+	}{
+		{
+			name:     "no update policy",
+			rawState: map[string]interface{}{"id": "sig-12345", "should_resume_stateful": true},
+			expected: []interface{}{map[string]interface{}{"should_resume_stateful": true, "should_roll": false}},
+		},
+		{
+			name: "update policy",
+			rawState: map[string]interface{}{
+				"id":                     "sig-12345",
+				"should_resume_stateful": true,
+				"update_policy":          []interface{}{map[string]interface{}{"should_roll": true}},
+			},
+			expected: []interface{}{map[string]interface{}{"should_resume_stateful": true, "should_roll": true}},
+		},
+		{
+			name: "update policy already sets the attribute",
+			rawState: map[string]interface{}{
+				"id":                     "sig-12345",
+				"should_resume_stateful": true,
+				"update_policy":          []interface{}{map[string]interface{}{"should_resume_stateful": false, "should_roll": false}},
+			},
+			expected: []interface{}{map[string]interface{}{"should_resume_stateful": false, "should_roll": false}},
+		},
+		{
+			name:     "null attribute",
+			rawState: map[string]interface{}{"id": "sig-12345", "should_resume_stateful": nil},
+			expected: nil,
+		},
+		{
+			name:     "current state",
+			rawState: map[string]interface{}{"id": "sig-12345"},
+			expected: nil,
+		},
+	}
+
+	upgraders := resourceSpotinstElastigroupAWS().StateUpgraders
+	if len(upgraders) != 1 {
+		t.Fatalf("expected 1 upgrader, got %d", len(upgraders))
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			upgraded, err := upgraders[0].Upgrade(context.Background(), c.rawState, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := upgraded["should_resume_stateful"]; ok {
+				t.Errorf("expected should_resume_stateful to be removed from the top level")
+			}
+			if !reflect.DeepEqual(upgraded["update_policy"], c.expected) {
+				t.Errorf("expected %v, got %v", c.expected, upgraded["update_policy"])
+			}
+		})
+	}
+}
+
+// endregion
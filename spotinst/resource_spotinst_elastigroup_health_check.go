@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/service/healthcheck"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
@@ -32,6 +33,9 @@ func resourceSpotinstHealthCheck() *schema.Resource {
 		},
 
 		Schema: commons.HealthCheckResource.GetSchemaMap(),
+
+		SchemaVersion:  commons.HealthCheckResource.GetSchemaVersion(),
+		StateUpgraders: commons.HealthCheckResource.GetStateUpgraders(),
 	}
 }
 
@@ -74,10 +78,87 @@ func resourceSpotinstHealthCheckRead(ctx context.Context, resourceData *schema.R
 	if err := commons.HealthCheckResource.OnRead(HealthCheckResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	if v, ok := resourceData.GetOk(string(health_check.Check)); ok {
+		ids, err := readCheckIDs(resourceId, v, meta.(*Client))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := resourceData.Set(string(health_check.Check), health_check.SetCheckIDs(v, ids)); err != nil {
+			return diag.Errorf(string(commons.FailureFieldReadPattern), string(health_check.Check), err)
+		}
+	}
+
+	if err := readHealthCheckStatus(spotinst.StringValue(HealthCheckResponse.ResourceID), resourceData, meta.(*Client)); err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("===> HealthCheck read successfully: %s <===", resourceId)
 	return nil
 }
 
+// readCheckIDs returns the health check IDs of the check blocks in state.
+// The first block is the resource itself. The ID of a block whose health
+// check no longer exists is empty.
+func readCheckIDs(resourceId string, data interface{}, spotinstClient *Client) ([]string, error) {
+	checks, err := health_check.ExpandChecks(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(checks))
+	for i, check := range checks {
+		if i == 0 {
+			ids[i] = resourceId
+			continue
+		}
+		if check.HealthCheckID == "" {
+			continue
+		}
+		input := &healthcheck.ReadHealthCheckInput{HealthCheckID: spotinst.String(check.HealthCheckID)}
+		resp, err := spotinstClient.healthCheck.Read(context.Background(), input)
+		if err != nil {
+			if commons.IsNotFoundError(err, ErrCodeHealthCheckNotFound) {
+				log.Printf("[WARN] HealthCheck %s of check %d not found", check.HealthCheckID, i)
+				continue
+			}
+			return nil, fmt.Errorf("failed to read health check %s: %s", check.HealthCheckID, err)
+		}
+		if resp.HealthCheck != nil {
+			ids[i] = spotinst.StringValue(resp.HealthCheck.ID)
+		}
+	}
+	return ids, nil
+}
+
+// readHealthCheckStatus reads the health status of the instances of the
+// checked resource. Only AWS Elastigroups expose it; for other resources the
+// status is empty.
+func readHealthCheckStatus(resourceId string, resourceData *schema.ResourceData, spotinstClient *Client) error {
+	var instances []*aws.InstanceHealth
+	if strings.HasPrefix(resourceId, health_check.ElastigroupIDPrefix) {
+		input := &aws.GetInstanceHealthinessInput{GroupID: spotinst.String(resourceId)}
+		resp, err := spotinstClient.elastigroup.CloudProviderAWS().GetInstanceHealthiness(context.Background(), input)
+		if err != nil {
+			// GCP and Azure groups, or groups that were deleted.
+			if !commons.IsNotFoundError(err, notFoundErrorCodes...) {
+				return fmt.Errorf("failed to read instance health of %s: %s", resourceId, err)
+			}
+			log.Printf("[WARN] Cannot read instance health of %s: %s", resourceId, err)
+		} else {
+			instances = resp.Instances
+		}
+	}
+
+	healthy, unhealthy := health_check.InstanceHealthStatus(instances)
+	if err := resourceData.Set(string(health_check.HealthyInstanceCount), healthy); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(health_check.HealthyInstanceCount), err)
+	}
+	if err := resourceData.Set(string(health_check.UnhealthyInstances), unhealthy); err != nil {
+		return fmt.Errorf(string(commons.FailureFieldReadPattern), string(health_check.UnhealthyInstances), err)
+	}
+	return nil
+}
+
 func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf(string(commons.ResourceOnCreate), commons.HealthCheckResource.GetName())
@@ -94,6 +175,23 @@ func resourceSpotinstHealthCheckCreate(ctx context.Context, resourceData *schema
 
 	resourceData.SetId(spotinst.StringValue(healthCheckId))
 
+	// Every check after the first one is a health check of its own. The
+	// health checks created so far are kept in state when one fails, so
+	// they are deleted along with the resource.
+	checks, err := health_check.ExpandChecks(resourceData.Get(string(health_check.Check)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(checks) > 1 {
+		ids, _, syncErr := checkHealthChecks(resourceData, healthCheck, checks, meta.(*Client)).Sync(resourceData.Id(), nil, len(checks))
+		if err := resourceData.Set(string(health_check.Check), health_check.SetCheckIDs(resourceData.Get(string(health_check.Check)), ids)); err != nil {
+			return diag.FromErr(err)
+		}
+		if syncErr != nil {
+			return diag.FromErr(syncErr)
+		}
+	}
+
 	log.Printf("===> HealthCheck created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstHealthCheckRead(ctx, resourceData, meta)
@@ -133,6 +231,17 @@ func createHealthCheck(resourceData *schema.ResourceData, healthCheck *healthche
 
 }
 
+// checkHealthCheck returns a copy of healthCheck that runs check.
+func checkHealthCheck(healthCheck *healthcheck.HealthCheck, check *healthcheck.Check) *healthcheck.HealthCheck {
+	checkHealthCheck := &healthcheck.HealthCheck{}
+	checkHealthCheck.SetName(healthCheck.Name)
+	checkHealthCheck.SetResourceId(healthCheck.ResourceID)
+	checkHealthCheck.SetProxyAddr(healthCheck.ProxyAddr)
+	checkHealthCheck.SetProxyPort(healthCheck.ProxyPort)
+	checkHealthCheck.SetCheck(check)
+	return checkHealthCheck
+}
+
 func resourceSpotinstHealthCheckUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate), commons.HealthCheckResource.GetName(), resourceId)
@@ -148,10 +257,78 @@ func resourceSpotinstHealthCheckUpdate(ctx context.Context, resourceData *schema
 			return diag.FromErr(err)
 		}
 	}
+
+	// Every change to a shared field or a check is applied to all checks.
+	if shouldUpdate {
+		if err := updateCheckHealthChecks(resourceData, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	log.Printf("===> HealthCheck updated successfully: %s <===", resourceId)
 	return resourceSpotinstHealthCheckRead(ctx, resourceData, meta)
 }
 
+// updateCheckHealthChecks updates the health checks of the checks after the
+// first one, creates the health checks of new checks and deletes those of
+// removed checks. Checks are matched by position. The health checks of
+// removed checks that could not be deleted are kept in state, so that they
+// are deleted on the next apply.
+func updateCheckHealthChecks(resourceData *schema.ResourceData, meta interface{}) error {
+	o, n := resourceData.GetChange(string(health_check.Check))
+	oldChecks, _ := health_check.ExpandChecks(o)
+	newChecks, err := health_check.ExpandChecks(n)
+	if err != nil {
+		return err
+	}
+
+	// The full health check, since the checks share all of its other fields.
+	healthCheck, err := commons.HealthCheckResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+
+	oldIDs := make([]string, len(oldChecks))
+	for i, check := range oldChecks {
+		oldIDs[i] = check.HealthCheckID
+	}
+	ids, removed, syncErr := checkHealthChecks(resourceData, healthCheck, newChecks, meta.(*Client)).Sync(resourceData.Id(), oldIDs, len(newChecks))
+
+	checks := health_check.SetCheckIDs(n, ids)
+	oldList, _ := o.([]interface{})
+	for _, i := range removed {
+		checks = append(checks, oldList[i])
+	}
+	if err := resourceData.Set(string(health_check.Check), checks); err != nil {
+		return err
+	}
+	return syncErr
+}
+
+// checkHealthChecks returns the health checks of the checks after the first
+// one, which share the other fields of healthCheck.
+func checkHealthChecks(resourceData *schema.ResourceData, healthCheck *healthcheck.HealthCheck,
+	checks []*health_check.ResourceCheck, spotinstClient *Client) *commons.PositionalChildren {
+
+	return &commons.PositionalChildren{
+		Create: func(i int) (string, error) {
+			id, err := createHealthCheck(resourceData, checkHealthCheck(healthCheck, checks[i].Check), spotinstClient)
+			return spotinst.StringValue(id), err
+		},
+		Update: func(i int, id string) error {
+			checkHC := checkHealthCheck(healthCheck, checks[i].Check)
+			checkHC.SetId(spotinst.String(id))
+			input := &healthcheck.UpdateHealthCheckInput{HealthCheck: checkHC}
+			if _, err := spotinstClient.healthCheck.Update(context.Background(), input); err != nil {
+				return fmt.Errorf("[ERROR] Failed to update HealthCheck [%v]: %v", id, err)
+			}
+			return nil
+		},
+		Delete: func(id string) error {
+			return deleteCheckHealthCheck(id, spotinstClient)
+		},
+	}
+}
+
 func updateHealthCheck(healthCheck *healthcheck.HealthCheck, resourceData *schema.ResourceData, meta interface{}) error {
 	var input = &healthcheck.UpdateHealthCheckInput{
 		HealthCheck: healthCheck,
@@ -175,6 +352,13 @@ func resourceSpotinstHealthCheckDelete(ctx context.Context, resourceData *schema
 	resourceId := resourceData.Id()
 	log.Printf(string(commons.ResourceOnDelete), commons.HealthCheckResource.GetName(), resourceId)
 
+	checks, _ := health_check.ExpandChecks(resourceData.Get(string(health_check.Check)))
+	for i := 1; i < len(checks); i++ {
+		if err := deleteCheckHealthCheck(checks[i].HealthCheckID, meta.(*Client)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if err := deleteHealthCheck(resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
@@ -200,3 +384,19 @@ func deleteHealthCheck(resourceData *schema.ResourceData, meta interface{}) erro
 	}
 	return nil
 }
+
+// deleteCheckHealthCheck deletes the health check of a check after the
+// first one, if it was created and still exists.
+func deleteCheckHealthCheck(healthCheckId string, spotinstClient *Client) error {
+	if healthCheckId == "" {
+		return nil
+	}
+	input := &healthcheck.DeleteHealthCheckInput{HealthCheckID: spotinst.String(healthCheckId)}
+	if _, err := spotinstClient.healthCheck.Delete(context.Background(), input); err != nil {
+		if commons.IsNotFoundError(err, ErrCodeHealthCheckNotFound) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] onDelete() -> Failed to delete HealthCheck %s: %s", healthCheckId, err)
+	}
+	return nil
+}
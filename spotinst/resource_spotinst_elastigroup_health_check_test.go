@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -210,3 +211,121 @@ resource "` + string(commons.HealthCheckResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region HealthCheck: Multiple Checks
+func TestAccSpotinstHealthCheck_MultipleChecks(t *testing.T) {
+	name := "test-acc-health_check_terraform_test_multiple_checks"
+	resourceName := createHealthCheckResourceName(name)
+
+	var healthCheck healthcheck.HealthCheck
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testHealthCheckDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createHealthCheckTerraform(&HealthCheckMetadata{
+					name:           name,
+					fieldsToAppend: testMultipleChecksHealthCheckConfig_Create,
+				}, testBaselineHealthCheckConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "check.0.health_check_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "check.1.health_check_id"),
+					resource.TestCheckResourceAttr(resourceName, "check.1.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "check.1.port", "22"),
+					resource.TestCheckResourceAttrSet(resourceName, "healthy_instance_count"),
+				),
+			},
+			{
+				Config: createHealthCheckTerraform(&HealthCheckMetadata{
+					name:           name,
+					fieldsToAppend: testMultipleChecksHealthCheckConfig_Update,
+				}, testBaselineHealthCheckConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "check.1.port", "2222"),
+					resource.TestCheckResourceAttr(resourceName, "check.2.protocol", "https"),
+					resource.TestCheckResourceAttrSet(resourceName, "check.2.health_check_id"),
+				),
+			},
+			{
+				Config: createHealthCheckTerraform(&HealthCheckMetadata{
+					name: name,
+				}, testBaselineHealthCheckConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckHealthCheckExists(&healthCheck, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "check.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testMultipleChecksHealthCheckConfig_Create = `
+  check {
+    protocol = "tcp"
+    port = "22"
+    interval = "11"
+    timeout = "12"
+    unhealthy  = "3"
+    healthy = "2"
+  }
+`
+
+const testMultipleChecksHealthCheckConfig_Update = `
+  check {
+    protocol = "tcp"
+    port = "2222"
+    interval = "11"
+    timeout = "12"
+    unhealthy  = "3"
+    healthy = "2"
+  }
+  check {
+    protocol = "https"
+    port = "443"
+    endpoint = "https://endpoint.com"
+    interval = "15"
+    timeout = "13"
+    unhealthy  = "2"
+    healthy = "3"
+  }
+`
+
+// endregion
+
+// region State upgrade
+
+func TestHealthCheckStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":          "hc-12345",
+		"resource_id": "sig-12345",
+		"check": []interface{}{
+			map[string]interface{}{"protocol": "http", "port": float64(80), "end_point": "/health", "time_out": float64(10)},
+			map[string]interface{}{"protocol": "tcp", "port": float64(22), "endpoint": "/", "end_point": "/old", "time_out": float64(5), "timeout": float64(0)},
+		},
+	}
+
+	upgraders := resourceSpotinstHealthCheck().StateUpgraders
+	if len(upgraders) != 1 {
+		t.Fatalf("expected 1 upgrader, got %d", len(upgraders))
+	}
+	upgraded, err := upgraders[0].Upgrade(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"protocol": "http", "port": float64(80), "end_point": "/health", "endpoint": "/health", "time_out": float64(10), "timeout": float64(10)},
+		map[string]interface{}{"protocol": "tcp", "port": float64(22), "endpoint": "/", "end_point": "/old", "time_out": float64(5), "timeout": float64(5)},
+	}
+	if !reflect.DeepEqual(upgraded["check"], expected) {
+		t.Errorf("expected %v, got %v", expected, upgraded["check"])
+	}
+}
+
+// endregion
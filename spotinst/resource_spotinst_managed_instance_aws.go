@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -96,6 +97,10 @@ func resourceSpotinstManagedInstanceAWSRead(ctx context.Context, resourceData *s
 	if err := commons.ManagedInstanceResource.OnRead(managedInstanceResponse, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	if err := readManagedInstanceStatus(ctx, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
 	log.Printf("===> ManagedInstance read successfully: %s <===", id)
 	return nil
 }
@@ -116,6 +121,10 @@ func resourceSpotinstManagedInstanceAWSCreate(ctx context.Context, resourceData
 
 	resourceData.SetId(spotinst.StringValue(ManagedInstanceId))
 
+	if err := awaitManagedInstanceState(ctx, resourceData, meta); err != nil {
+		return diag.Errorf("[ERROR] Failed to wait for managed instance state after create: %s", err)
+	}
+
 	log.Printf("===> ManagedInstance created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstManagedInstanceAWSRead(ctx, resourceData, meta)
@@ -174,6 +183,10 @@ func resourceSpotinstManagedInstanceAWSUpdate(ctx context.Context, resourceData
 		if err := updateAWSManagedInstance(managedInstance, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
+
+		if err := awaitManagedInstanceState(ctx, resourceData, meta); err != nil {
+			return diag.Errorf("[ERROR] Failed to wait for managed instance state after update: %s", err)
+		}
 	}
 
 	log.Printf("===> ManagedInstance updated successfully: %s <===", id)
@@ -227,6 +240,86 @@ func updateAWSManagedInstance(managedInstance *aws.ManagedInstance, resourceData
 	return nil
 }
 
+// readManagedInstanceStatus sets the attributes that come from the status of
+// the managed instance. The status is informational, so when it cannot be
+// read the previous values are kept rather than failing the read.
+func readManagedInstanceStatus(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	input := &aws.StatusManagedInstanceInput{ManagedInstanceID: spotinst.String(resourceData.Id())}
+	status, err := meta.(*Client).managedInstance.CloudProviderAWS().Status(ctx, input)
+	if err != nil {
+		log.Printf("[WARN] Failed to read status of managed instance (%s), keeping the previous values: %v", resourceData.Id(), err)
+		return nil
+	}
+
+	fields := map[commons.FieldName]*string{
+		managed_instance_aws.InstanceID:  status.InstanceID,
+		managed_instance_aws.PrivateIP:   status.PrivateIP,
+		managed_instance_aws.PublicIP:    status.PublicIP,
+		managed_instance_aws.IPv6Address: status.IPv6Address,
+		managed_instance_aws.State:       status.Status,
+	}
+	for field, value := range fields {
+		if err := resourceData.Set(string(field), spotinst.StringValue(value)); err != nil {
+			return fmt.Errorf(string(commons.FailureFieldReadPattern), string(field), err)
+		}
+	}
+
+	return nil
+}
+
+// managedInstanceFailedStates are the states in which waiting for
+// wait_for_state stops right away.
+var managedInstanceFailedStates = []string{
+	managed_instance_aws.StateError,
+	managed_instance_aws.StateDeallocating,
+	managed_instance_aws.StateDeallocated,
+}
+
+// defaultManagedInstanceStateTimeout is used when wait_for_state is set
+// without wait_for_state_timeout.
+const defaultManagedInstanceStateTimeout = 10 * time.Minute
+
+func awaitManagedInstanceState(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) error {
+	state, ok := resourceData.GetOk(string(managed_instance_aws.WaitForState))
+	if !ok {
+		return nil
+	}
+
+	timeout := defaultManagedInstanceStateTimeout
+	if v, ok := resourceData.GetOk(string(managed_instance_aws.WaitForStateTimeout)); ok {
+		timeout = time.Duration(v.(int)) * time.Second
+	}
+
+	id := resourceData.Id()
+	svc := meta.(*Client).managedInstance.CloudProviderAWS()
+	log.Printf("Waiting for managed instance (%s) to become %s", id, state)
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		input := &aws.StatusManagedInstanceInput{ManagedInstanceID: spotinst.String(id)}
+		status, err := svc.Status(ctx, input)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("failed to read status of managed instance (%s): %v", id, err))
+		}
+
+		current := spotinst.StringValue(status.Status)
+		if slices.Contains(managedInstanceFailedStates, current) {
+			return resource.NonRetryableError(fmt.Errorf("managed instance (%s) is %q and will not become %q", id, current, state))
+		}
+		if current != state.(string) {
+			log.Printf("===> managed instance (%s) is %q, waiting for %q <===", id, current, state)
+			return resource.RetryableError(fmt.Errorf("managed instance (%s) is %q, expected %q", id, current, state))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Managed instance (%s) is %s", id, state)
+	return nil
+}
+
 func pauseManagedInstance(ctx context.Context, svc aws.Service, instanceID string) error {
 	log.Printf("Pausing managed instance (%s)", instanceID)
 
@@ -186,6 +186,9 @@ func TestAccSpotinstManagedInstanceBaseline(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.1", "subnet-8ab89cc1"),
 					resource.TestCheckResourceAttr(resourceName, "subnet_ids.2", "subnet-42f1e418"),
 					resource.TestCheckResourceAttr(resourceName, "vpc_id", "vpc-b6923bce"),
+					resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip"),
 				),
 			},
 			{
@@ -232,6 +235,7 @@ resource "` + string(commons.ManagedInstanceAWSResourceName) + `" "%v" {
   instance_types = ["t3.xlarge"]
   preferred_type = "t3.xlarge"
   image_id = "ami-082b5a644766e0e6f"
+  wait_for_state = "ACTIVE"
    tags {
     key = "creator"
     value = "terraform-automation"
@@ -639,7 +643,7 @@ func TestAccSpotinstManagedInstanceScheduling(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.is_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.task_type", "pause"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.cron_expression", "cron"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.cron_expression", "0 4 * * *"),
 				),
 			},
 			{
@@ -686,7 +690,7 @@ const managedInstanceScheduling_Update = `
 
   scheduled_task {
     task_type             = "pause"
-    cron_expression       = "cron"
+    cron_expression       = "0 4 * * *"
     is_enabled            = "true"
   }
 `
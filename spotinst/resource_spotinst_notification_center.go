@@ -3,13 +3,21 @@ package spotinst
 import (
 	"context"
 	"fmt"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	elastigroupAWS "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/aws"
+	elastigroupAzure "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/azure/v3"
+	elastigroupGCP "github.com/spotinst/spotinst-sdk-go/service/elastigroup/providers/gcp"
+	managedInstanceAWS "github.com/spotinst/spotinst-sdk-go/service/managedinstance/providers/aws"
 	"github.com/spotinst/spotinst-sdk-go/service/notificationcenter"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/azure_np"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/gcp"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/notification_center"
-	"log"
 )
 
 func resourceSpotinstNotificationCenter() *schema.Resource {
@@ -19,6 +27,7 @@ func resourceSpotinstNotificationCenter() *schema.Resource {
 		UpdateContext: resourceSpotinstNotificationCenterUpdate,
 		ReadContext:   resourceSpotinstNotificationCenterRead,
 		DeleteContext: resourceSpotinstNotificationCenterDelete,
+		CustomizeDiff: resourceSpotinstNotificationCenterCustomizeDiff,
 
 		Schema: commons.NotificationCenterResource.GetSchemaMap(),
 	}
@@ -31,6 +40,99 @@ func setupNotificationCenter() {
 	commons.NotificationCenterResource = commons.NewNotificationCenterResource(fieldsMap)
 }
 
+// resourceSpotinstNotificationCenterCustomizeDiff verifies that the Ocean
+// clusters, Elastigroups and managed instances referenced by the policy
+// exist, so that typos fail at plan time rather than silently matching
+// nothing.
+func resourceSpotinstNotificationCenterCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*Client)
+	if !ok || client == nil {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(string(notification_center.ComputePolicyConfig)) {
+		return nil
+	}
+
+	lookups := map[commons.FieldName][]func(ctx context.Context, id string) error{
+		notification_center.OceanIDs: {
+			func(ctx context.Context, id string) error {
+				_, err := client.ocean.CloudProviderAWS().ReadCluster(ctx, &aws.ReadClusterInput{ClusterID: spotinst.String(id)})
+				return err
+			},
+			func(ctx context.Context, id string) error {
+				_, err := client.ocean.CloudProviderAWS().ReadECSCluster(ctx, &aws.ReadECSClusterInput{ClusterID: spotinst.String(id)})
+				return err
+			},
+			func(ctx context.Context, id string) error {
+				_, err := client.ocean.CloudProviderGCP().ReadCluster(ctx, &gcp.ReadClusterInput{ClusterID: spotinst.String(id)})
+				return err
+			},
+			func(ctx context.Context, id string) error {
+				_, err := client.ocean.CloudProviderAzureNP().ReadCluster(ctx, &azure_np.ReadClusterInput{ClusterID: spotinst.String(id)})
+				return err
+			},
+		},
+		notification_center.ElastigroupIDs: {
+			func(ctx context.Context, id string) error {
+				_, err := client.elastigroup.CloudProviderAWS().Read(ctx, &elastigroupAWS.ReadGroupInput{GroupID: spotinst.String(id)})
+				return err
+			},
+			func(ctx context.Context, id string) error {
+				_, err := client.elastigroup.CloudProviderGCP().Read(ctx, &elastigroupGCP.ReadGroupInput{GroupID: spotinst.String(id)})
+				return err
+			},
+			func(ctx context.Context, id string) error {
+				_, err := client.elastigroup.CloudProviderAzureV3().Read(ctx, &elastigroupAzure.ReadGroupInput{GroupID: spotinst.String(id)})
+				return err
+			},
+		},
+		notification_center.ManagedInstanceIDs: {
+			func(ctx context.Context, id string) error {
+				_, err := client.managedInstance.CloudProviderAWS().Read(ctx, &managedInstanceAWS.ReadManagedInstanceInput{ManagedInstanceID: spotinst.String(id)})
+				return err
+			},
+		},
+	}
+
+	for field, ids := range notification_center.ReferencedResourceIDs(diff) {
+		for _, id := range ids {
+			found, err := resourceExists(ctx, id, lookups[field])
+			if err != nil {
+				return fmt.Errorf("%s: failed to verify %s: %s", field, id, err)
+			}
+			if !found {
+				return fmt.Errorf("%s: resource %s does not exist", field, id)
+			}
+		}
+	}
+	return nil
+}
+
+// resourceExists reads a resource from each of the clouds it can belong to.
+// It is considered missing when every read reports that it was not found.
+func resourceExists(ctx context.Context, id string, lookups []func(ctx context.Context, id string) error) (bool, error) {
+	for _, lookup := range lookups {
+		err := lookup(ctx, id)
+		if err == nil {
+			return true, nil
+		}
+		if !commons.IsNotFoundError(err, notFoundErrorCodes...) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+// notFoundErrorCodes are the codes the API answers reads of missing
+// resources with, often with a 400 status.
+var notFoundErrorCodes = []string{
+	ErrCodeGroupNotFound,
+	ErrCodeClusterNotFound,
+	ErrCodeECSClusterNotFound,
+	ErrCodeLaunchSpecNotFound,
+	ErrCodeManagedInstanceDoesntExist,
+}
+
 func resourceSpotinstNotificationCenterRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnRead),
@@ -55,6 +55,25 @@ func testCheckNotificationCenterExists(sub *notificationcenter.NotificationCente
 	}
 }
 
+// testCheckNotificationCenterTagRule checks that the API stored a tag rule
+// as a dynamic rule with the expected expression.
+func testCheckNotificationCenterTagRule(nc *notificationcenter.NotificationCenter, expression string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if nc.ComputePolicyConfig == nil {
+			return fmt.Errorf("no compute policy config")
+		}
+		for _, rule := range nc.ComputePolicyConfig.DynamicRules {
+			for _, condition := range rule.FilterConditions {
+				if spotinst.StringValue(condition.Identifier) == "tag" &&
+					spotinst.StringValue(condition.Expression) == expression {
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("no tag rule with expression %q: %+v", expression, nc.ComputePolicyConfig.DynamicRules)
+	}
+}
+
 func createNotificationCenterTerraform(tfResource string, resourceName string) string {
 	template := ""
 
@@ -174,3 +193,60 @@ resource "` + string(commons.NotificationCenterResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region Notification Center: Tag Rules
+func TestAccSpotinstNotificationCenter_TagRules(t *testing.T) {
+	notificationcenterName := "notification-center-tag-rules"
+	ncResourceName := createNotificationCenterResourceName(notificationcenterName)
+
+	var notificationcenter notificationcenter.NotificationCenter
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testNotificationCeneterDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createNotificationCenterTerraform(testNotificationCenter_TagRules, notificationcenterName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckNotificationCenterExists(&notificationcenter, ncResourceName),
+					testCheckNotificationCenterTagRule(&notificationcenter, "tag EQUALS 'Environment:production'"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.#", "1"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.0.should_include_all_resources", "false"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.0.tag_rules.#", "1"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.0.tag_rules.0.key", "Environment"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.0.tag_rules.0.value", "production"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.0.tag_rules.0.operator", "equals"),
+					resource.TestCheckResourceAttr(ncResourceName, "compute_policy_config.0.dynamic_rules.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testNotificationCenter_TagRules = `
+resource "` + string(commons.NotificationCenterResourceName) + `" "%v" {
+  provider = "aws"
+  name="Notification-center-terraform-tag-rules"
+  description="Testing of notification center policy tag rules"
+  is_active=true
+  privacy_level="public"
+  registered_users {
+	user_email="TestAutomation_Admin_DO_NOT_DELETE@spot.io"
+	subscription_types = ["email"]
+  }
+  compute_policy_config {
+	events {
+	  event="Maximum capacity reached"
+	  event_type="WARN"
+	}
+	should_include_all_resources = false
+	tag_rules {
+	  key="Environment"
+	  value="production"
+	}
+  }
+}
+`
+
+// endregion
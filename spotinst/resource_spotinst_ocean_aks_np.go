@@ -32,6 +32,7 @@ func resourceSpotinstOceanAKSNP() *schema.Resource {
 		ReadContext:   resourceSpotinstClusterAKSNPRead,
 		UpdateContext: resourceSpotinstClusterAKSNPUpdate,
 		DeleteContext: resourceSpotinstClusterAKSNPDelete,
+		CustomizeDiff: resourceSpotinstClusterAKSNPCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -41,6 +42,10 @@ func resourceSpotinstOceanAKSNP() *schema.Resource {
 	}
 }
 
+func resourceSpotinstClusterAKSNPCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ocean_aks_np_vm_sizes.CustomizeDiff(diff)
+}
+
 func setupClusterAKSNPResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
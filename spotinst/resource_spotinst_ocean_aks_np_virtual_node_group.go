@@ -31,7 +31,7 @@ func resourceSpotinstOceanAKSNPVirtualNodeGroup() *schema.Resource {
 		DeleteContext: resourceSpotinstOceanAKSNPVirtualNodeGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSpotinstOceanAKSNPVirtualNodeGroupImportState,
 		},
 
 		Schema: commons.OceanAKSNPVirtualNodeGroupResource.GetSchemaMap(),
@@ -52,6 +52,40 @@ func setupOceanAKSNPVirtualNodeGroupResource() {
 	commons.OceanAKSNPVirtualNodeGroupResource = commons.NewOceanAKSNPVirtualNodeGroupResource(fieldsMap)
 }
 
+// resourceSpotinstOceanAKSNPVirtualNodeGroupImportState supports importing a virtual node group
+// either by its ID or by `<ocean_id>/<virtual_node_group_name>`.
+func resourceSpotinstOceanAKSNPVirtualNodeGroupImportState(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	oceanID, name, ok := commons.ParseOceanChildImportID(resourceData.Id())
+	if !ok {
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	input := &azure_np.ListVirtualNodeGroupsInput{OceanID: spotinst.String(oceanID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAzureNP().ListVirtualNodeGroups(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list virtual node groups of cluster %q: %v", oceanID, err)
+	}
+
+	virtualNodeGroups := make([]commons.NamedResource, 0, len(resp.VirtualNodeGroups))
+	for _, virtualNodeGroup := range resp.VirtualNodeGroups {
+		virtualNodeGroups = append(virtualNodeGroups, commons.NamedResource{
+			ID:   spotinst.StringValue(virtualNodeGroup.ID),
+			Name: spotinst.StringValue(virtualNodeGroup.Name),
+		})
+	}
+
+	id, err := commons.ResolveIDByName("virtual node group", oceanID, name, virtualNodeGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceData.SetId(id)
+	if err := resourceData.Set(string(ocean_aks_np_virtual_node_group.OceanID), oceanID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{resourceData}, nil
+}
+
 // region Create
 
 func resourceSpotinstOceanAKSNPVirtualNodeGroupCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
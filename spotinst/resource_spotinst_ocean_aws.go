@@ -17,6 +17,7 @@ import (
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_auto_scaling"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_extended_resource_definition"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_instance_types"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_launch_configuration"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_logging"
@@ -32,6 +33,7 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 		ReadContext:   resourceSpotinstClusterAWSRead,
 		UpdateContext: resourceSpotinstClusterAWSUpdate,
 		DeleteContext: resourceSpotinstClusterAWSDelete,
+		CustomizeDiff: resourceSpotinstClusterAWSCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -40,6 +42,41 @@ func resourceSpotinstOceanAWS() *schema.Resource {
 	}
 }
 
+func resourceSpotinstClusterAWSCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := ocean_aws_instance_types.CustomizeDiff(diff); err != nil {
+		return err
+	}
+	return validateClusterAWSExtendedResourceDefinitions(ctx, diff, meta)
+}
+
+// validateClusterAWSExtendedResourceDefinitions verifies that the extended
+// resource definitions referenced by the autoscaler exist, since the API
+// silently ignores unknown ones.
+func validateClusterAWSExtendedResourceDefinitions(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s.0.%s", ocean_aws_auto_scaling.Autoscaler, ocean_aws_auto_scaling.ExtendedResourceDefinitions)
+	if diff.Id() != "" && !diff.HasChange(key) {
+		return nil
+	}
+	if !diff.NewValueKnown(key) {
+		return nil
+	}
+	ids := expandList(diff.Get(key))
+	if len(ids) == 0 {
+		return nil
+	}
+
+	defined, err := listExtendedResourceDefinitions(ctx, client)
+	if err != nil {
+		return err
+	}
+	return ocean_aws_extended_resource_definition.ValidateReferences(key, ids, defined)
+}
+
 func setupClusterAWSResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
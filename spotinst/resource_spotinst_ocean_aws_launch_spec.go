@@ -15,6 +15,7 @@ import (
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_extended_resource_definition"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_launch_spec"
 )
 
@@ -28,11 +29,62 @@ func resourceSpotinstOceanAWSLaunchSpec() *schema.Resource {
 		DeleteContext: resourceSpotinstOceanAWSLaunchSpecDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSpotinstOceanAWSLaunchSpecImportState,
 		},
 
 		Schema: commons.OceanAWSLaunchSpecResource.GetSchemaMap(),
+
+		CustomizeDiff: resourceSpotinstOceanAWSLaunchSpecCustomizeDiff,
+	}
+}
+
+// resourceSpotinstOceanAWSLaunchSpecCustomizeDiff fails the plan when an
+// extended resource definition of the cluster has no mapping for an instance
+// type of the launch spec, since instances without a mapping don't advertise
+// the extended resource. Launch specs whose workloads don't need every
+// definition of the cluster can set allow_unmapped_extended_resources.
+func resourceSpotinstOceanAWSLaunchSpecCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	oceanIDKey := string(ocean_aws_launch_spec.OceanID)
+	instanceTypesKey := string(ocean_aws_launch_spec.InstanceTypes)
+	allowUnmappedKey := string(ocean_aws_launch_spec.AllowUnmappedExtendedResources)
+	if diff.Get(allowUnmappedKey).(bool) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange(oceanIDKey) && !diff.HasChange(instanceTypesKey) && !diff.HasChange(allowUnmappedKey) {
+		return nil
+	}
+	if !diff.NewValueKnown(oceanIDKey) || !diff.NewValueKnown(instanceTypesKey) {
+		return nil
+	}
+	oceanID := diff.Get(oceanIDKey).(string)
+	instanceTypes := expandList(diff.Get(instanceTypesKey))
+	if oceanID == "" || len(instanceTypes) == 0 {
+		return nil
+	}
+
+	resp, err := client.ocean.CloudProviderAWS().ReadCluster(ctx, &aws.ReadClusterInput{ClusterID: spotinst.String(oceanID)})
+	if err != nil {
+		// The cluster is checked by the API when the launch spec is applied.
+		if commons.IsNotFoundError(err, notFoundErrorCodes...) {
+			return nil
+		}
+		return fmt.Errorf("%s: failed to read cluster %s: %v", oceanIDKey, oceanID, err)
+	}
+	if resp.Cluster == nil || resp.Cluster.AutoScaler == nil || len(resp.Cluster.AutoScaler.ExtendedResourceDefinitions) == 0 {
+		return nil
 	}
+
+	defined, err := listExtendedResourceDefinitions(ctx, client)
+	if err != nil {
+		return fmt.Errorf("%s: failed to list extended resource definitions: %v", instanceTypesKey, err)
+	}
+	return ocean_aws_extended_resource_definition.ValidateMappings(instanceTypesKey,
+		resp.Cluster.AutoScaler.ExtendedResourceDefinitions, defined, instanceTypes)
 }
 
 func setupOceanAWSLaunchSpecResource() {
@@ -42,6 +94,40 @@ func setupOceanAWSLaunchSpecResource() {
 	commons.OceanAWSLaunchSpecResource = commons.NewOceanAWSLaunchSpecResource(fieldsMap)
 }
 
+// resourceSpotinstOceanAWSLaunchSpecImportState supports importing a launch spec either by its ID
+// or by `<ocean_id>/<launch_spec_name>`.
+func resourceSpotinstOceanAWSLaunchSpecImportState(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	oceanID, name, ok := commons.ParseOceanChildImportID(resourceData.Id())
+	if !ok {
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	input := &aws.ListLaunchSpecsInput{OceanID: spotinst.String(oceanID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListLaunchSpecs(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launchSpecs of cluster %q: %v", oceanID, err)
+	}
+
+	launchSpecs := make([]commons.NamedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, commons.NamedResource{
+			ID:   spotinst.StringValue(launchSpec.ID),
+			Name: spotinst.StringValue(launchSpec.Name),
+		})
+	}
+
+	id, err := commons.ResolveIDByName("launchSpec", oceanID, name, launchSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceData.SetId(id)
+	if err := resourceData.Set(string(ocean_aws_launch_spec.OceanID), oceanID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{resourceData}, nil
+}
+
 func resourceSpotinstOceanAWSLaunchSpecCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf(string(commons.ResourceOnCreate), commons.OceanAWSLaunchSpecResource.GetName())
 
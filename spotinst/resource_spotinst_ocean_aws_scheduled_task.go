@@ -0,0 +1,299 @@
+package spotinst
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/ocean/providers/aws"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/spotinst-sdk-go/spotinst/client"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/ocean_aws_cluster_scheduled_task"
+)
+
+func resourceSpotinstOceanAWSScheduledTask() *schema.Resource {
+	setupOceanAWSScheduledTaskResource()
+
+	return &schema.Resource{
+		CreateContext: resourceSpotinstOceanAWSScheduledTaskCreate,
+		ReadContext:   resourceSpotinstOceanAWSScheduledTaskRead,
+		UpdateContext: resourceSpotinstOceanAWSScheduledTaskUpdate,
+		DeleteContext: resourceSpotinstOceanAWSScheduledTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commons.OceanAWSScheduledTaskResource.GetSchemaMap(),
+	}
+}
+
+func setupOceanAWSScheduledTaskResource() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	ocean_aws_cluster_scheduled_task.Setup(fieldsMap)
+
+	commons.OceanAWSScheduledTaskResource = commons.NewOceanAWSScheduledTaskResource(fieldsMap)
+}
+
+func resourceSpotinstOceanAWSScheduledTaskCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.OceanAWSScheduledTaskResource.GetName())
+
+	task, err := commons.OceanAWSScheduledTaskResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oceanID := resourceData.Get(string(ocean_aws_cluster_scheduled_task.OceanID)).(string)
+	key := oceanAWSScheduledTaskKey(task)
+
+	if json, err := commons.ToJson(task); err != nil {
+		return diag.FromErr(err)
+	} else {
+		log.Printf("===> Scheduled task create configuration: %s", json)
+	}
+
+	err = updateOceanAWSScheduledTasks(ctx, oceanID, meta, func(tasks []*aws.Task) ([]*aws.Task, error) {
+		if matches := findOceanAWSScheduledTasks(tasks, key); len(matches) > 0 {
+			return nil, fmt.Errorf("scheduled task %q already exists in cluster %s, import it instead", key, oceanID)
+		}
+		return append(tasks, task), nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to create scheduled task: %s", err)
+	}
+
+	resourceData.SetId(oceanID + scheduledTaskIDSeparator + key)
+	log.Printf("===> Scheduled task created successfully: %s <===", resourceData.Id())
+
+	return resourceSpotinstOceanAWSScheduledTaskRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstOceanAWSScheduledTaskRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.OceanAWSScheduledTaskResource.GetName(), id)
+
+	oceanID, key, err := parseScheduledTaskID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cluster, err := readOceanAWSScheduledTasksCluster(ctx, oceanID, meta)
+	if err != nil {
+		return diag.Errorf("failed to read cluster: %s", err)
+	}
+
+	// If the cluster is gone, so is the task.
+	if cluster == nil {
+		resourceData.SetId("")
+		return nil
+	}
+
+	var tasks []*aws.Task
+	if cluster.Scheduling != nil {
+		tasks = cluster.Scheduling.Tasks
+	}
+
+	matches := findOceanAWSScheduledTasks(tasks, key)
+	switch len(matches) {
+	case 0:
+		resourceData.SetId("")
+		return nil
+	case 1:
+	default:
+		return diag.Errorf("found %d scheduled tasks matching %q in cluster %s", len(matches), key, oceanID)
+	}
+
+	if err := resourceData.Set(string(ocean_aws_cluster_scheduled_task.OceanID), oceanID); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(ocean_aws_cluster_scheduled_task.OceanID), err)
+	}
+
+	if err := commons.OceanAWSScheduledTaskResource.OnRead(tasks[matches[0]], resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Scheduled task read successfully: %s <===", id)
+	return nil
+}
+
+func resourceSpotinstOceanAWSScheduledTaskUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.OceanAWSScheduledTaskResource.GetName(), id)
+
+	oceanID, key, err := parseScheduledTaskID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateOceanAWSScheduledTasks(ctx, oceanID, meta, func(tasks []*aws.Task) ([]*aws.Task, error) {
+		matches := findOceanAWSScheduledTasks(tasks, key)
+		if len(matches) != 1 {
+			return nil, fmt.Errorf("found %d scheduled tasks matching %q in cluster %s", len(matches), key, oceanID)
+		}
+
+		shouldUpdate, err := commons.OceanAWSScheduledTaskResource.OnUpdate(tasks[matches[0]], resourceData, meta)
+		if err != nil {
+			return nil, err
+		}
+		if !shouldUpdate {
+			return nil, nil
+		}
+		return tasks, nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to update scheduled task: %s", err)
+	}
+
+	log.Printf("===> Scheduled task updated successfully: %s <===", id)
+	return resourceSpotinstOceanAWSScheduledTaskRead(ctx, resourceData, meta)
+}
+
+func resourceSpotinstOceanAWSScheduledTaskDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.OceanAWSScheduledTaskResource.GetName(), id)
+
+	oceanID, key, err := parseScheduledTaskID(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateOceanAWSScheduledTasks(ctx, oceanID, meta, func(tasks []*aws.Task) ([]*aws.Task, error) {
+		matches := findOceanAWSScheduledTasks(tasks, key)
+		if len(matches) == 0 {
+			return nil, nil
+		}
+
+		result := make([]*aws.Task, 0, len(tasks))
+		for i, task := range tasks {
+			if i != matches[0] {
+				result = append(result, task)
+			}
+		}
+		return result, nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to delete scheduled task: %s", err)
+	}
+
+	log.Printf("===> Scheduled task deleted successfully: %s <===", id)
+	resourceData.SetId("")
+	return nil
+}
+
+// errClusterModifiedConcurrently is returned when a cluster was modified by
+// someone else between reading it and writing its scheduled tasks back.
+var errClusterModifiedConcurrently = errors.New("cluster was modified concurrently")
+
+// updateOceanAWSScheduledTasks applies mutate to the scheduled tasks of a
+// cluster and writes them back, keeping the shutdown hours and optimization
+// windows as they are. Like updateElastigroupAWSGroupPartially, the update is
+// retried when the cluster's UpdatedAt changed between reading the cluster
+// and writing it back.
+func updateOceanAWSScheduledTasks(ctx context.Context, oceanID string, meta interface{},
+	mutate func(tasks []*aws.Task) ([]*aws.Task, error)) error {
+
+	commons.ResourceMutexKV.Lock(oceanID)
+	defer commons.ResourceMutexKV.Unlock(oceanID)
+
+	return resource.RetryContext(ctx, time.Minute, func() *resource.RetryError {
+		cluster, err := readOceanAWSScheduledTasksCluster(ctx, oceanID, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if cluster == nil {
+			return nil
+		}
+
+		scheduling := cluster.Scheduling
+		if scheduling == nil {
+			scheduling = &aws.Scheduling{}
+		}
+
+		tasks, err := mutate(scheduling.Tasks)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if tasks == nil {
+			return nil
+		}
+
+		if len(tasks) > 0 {
+			scheduling.SetTasks(tasks)
+		} else {
+			scheduling.SetTasks(nil)
+		}
+
+		update := &aws.Cluster{}
+		update.SetId(spotinst.String(oceanID))
+		update.SetScheduling(scheduling)
+
+		// Make sure nobody changed the cluster since we read it.
+		current, err := readOceanAWSScheduledTasksCluster(ctx, oceanID, meta)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if current == nil {
+			return nil
+		}
+		if !spotinst.TimeValue(current.UpdatedAt).Equal(spotinst.TimeValue(cluster.UpdatedAt)) {
+			log.Printf("[WARN] Cluster %s was modified concurrently, retrying", oceanID)
+			return resource.RetryableError(errClusterModifiedConcurrently)
+		}
+
+		if json, err := commons.ToJson(update); err != nil {
+			return resource.NonRetryableError(err)
+		} else {
+			log.Printf("===> Scheduled tasks update configuration: %s", json)
+		}
+
+		input := &aws.UpdateClusterInput{Cluster: update}
+		if _, err := meta.(*Client).ocean.CloudProviderAWS().UpdateCluster(ctx, input); err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// readOceanAWSScheduledTasksCluster reads a cluster, returning nil if it does
+// not exist.
+func readOceanAWSScheduledTasksCluster(ctx context.Context, oceanID string, meta interface{}) (*aws.Cluster, error) {
+	input := &aws.ReadClusterInput{ClusterID: spotinst.String(oceanID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ReadCluster(ctx, input)
+	if err != nil {
+		if errs, ok := err.(client.Errors); ok && len(errs) > 0 {
+			for _, err := range errs {
+				if err.Code == ErrCodeClusterNotFound {
+					return nil, nil
+				}
+			}
+		}
+		return nil, err
+	}
+	return resp.Cluster, nil
+}
+
+// oceanAWSScheduledTaskKey identifies a task within its cluster by type and
+// cron expression, since tasks have no ID of their own.
+func oceanAWSScheduledTaskKey(task *aws.Task) string {
+	return spotinst.StringValue(task.Type) + scheduledTaskIDSeparator + spotinst.StringValue(task.CronExpression)
+}
+
+func findOceanAWSScheduledTasks(tasks []*aws.Task, key string) []int {
+	var matches []int
+	for i, task := range tasks {
+		if oceanAWSScheduledTaskKey(task) == key {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
@@ -39,10 +39,17 @@ func resourceSpotinstOceanECS() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Schema: commons.OceanECSResource.GetSchemaMap(),
+		CustomizeDiff: resourceSpotinstClusterECSCustomizeDiff,
+		Schema:        commons.OceanECSResource.GetSchemaMap(),
 	}
 }
 
+// resourceSpotinstClusterECSCustomizeDiff verifies that a headroom given as a
+// task definition fits on the whitelisted instance types.
+func resourceSpotinstClusterECSCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ocean_ecs_autoscaler.CustomizeDiff(diff, string(ocean_ecs_instance_types.Whitelist))
+}
+
 func setupClusterECSResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 	ocean_ecs.Setup(fieldsMap)
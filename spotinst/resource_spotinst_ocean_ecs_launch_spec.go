@@ -28,13 +28,21 @@ func resourceSpotinstOceanECSLaunchSpec() *schema.Resource {
 		DeleteContext: resourceSpotinstOceanECSLaunchSpecDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSpotinstOceanECSLaunchSpecImportState,
 		},
 
-		Schema: commons.OceanECSLaunchSpecResource.GetSchemaMap(),
+		CustomizeDiff: resourceSpotinstOceanECSLaunchSpecCustomizeDiff,
+		Schema:        commons.OceanECSLaunchSpecResource.GetSchemaMap(),
 	}
 }
 
+// resourceSpotinstOceanECSLaunchSpecCustomizeDiff verifies that headrooms
+// given as task definitions fit on the launch spec instance types and
+// attributes.
+func resourceSpotinstOceanECSLaunchSpecCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ocean_ecs_launch_spec.CustomizeDiff(diff)
+}
+
 func setupOceanECSLaunchSpecResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 	ocean_ecs_launch_spec.Setup(fieldsMap)
@@ -42,6 +50,40 @@ func setupOceanECSLaunchSpecResource() {
 	commons.OceanECSLaunchSpecResource = commons.NewOceanECSLaunchSpecResource(fieldsMap)
 }
 
+// resourceSpotinstOceanECSLaunchSpecImportState supports importing a launch spec either by its ID
+// or by `<ocean_id>/<launch_spec_name>`.
+func resourceSpotinstOceanECSLaunchSpecImportState(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	oceanID, name, ok := commons.ParseOceanChildImportID(resourceData.Id())
+	if !ok {
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	input := &aws.ListECSLaunchSpecsInput{OceanID: spotinst.String(oceanID)}
+	resp, err := meta.(*Client).ocean.CloudProviderAWS().ListECSLaunchSpecs(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launchSpecs of cluster %q: %v", oceanID, err)
+	}
+
+	launchSpecs := make([]commons.NamedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, commons.NamedResource{
+			ID:   spotinst.StringValue(launchSpec.ID),
+			Name: spotinst.StringValue(launchSpec.Name),
+		})
+	}
+
+	id, err := commons.ResolveIDByName("launchSpec", oceanID, name, launchSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceData.SetId(id)
+	if err := resourceData.Set(string(ocean_ecs_launch_spec.OceanID), oceanID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{resourceData}, nil
+}
+
 func resourceSpotinstOceanECSLaunchSpecCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf(string(commons.ResourceOnCreate), commons.OceanECSLaunchSpecResource.GetName())
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -474,6 +475,119 @@ resource "` + string(commons.OceanECSLaunchSpecResourceName) + `" "%v" {
 
 //endregion
 
+// region OceanECSLaunchSpec: Task Definition Headroom
+func TestAccSpotinstOceanECSLaunchSpec_TaskDefinitionHeadroom(t *testing.T) {
+	oceanID := "o-19711548"
+	launchSpecName := "test-acc-ocean-ecs-launch-spec"
+	resourceName := createOceanECSLaunchSpecResourceOceanName(launchSpecName)
+
+	var launchSpec aws.ECSLaunchSpec
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOceanECSLaunchSpecDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOceanECSLaunchSpecTerraform(&ECSLaunchSpecConfigMetadata{
+					oceanID: oceanID,
+					name:    launchSpecName,
+				}, testTaskDefinitionHeadroomOceanECSLaunchSpecConfig_Create),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOceanECSLaunchSpecExists(&launchSpec, resourceName),
+					testCheckOceanECSLaunchSpecAttributes(&launchSpec, launchSpecName),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.0.num_of_units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.0.cpu_per_unit", "0"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_headrooms.0.memory_per_unit", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "autoscale_headrooms.0.task_definition"),
+					func(s *terraform.State) error {
+						headrooms := launchSpec.AutoScale.Headrooms
+						if len(headrooms) != 1 {
+							return fmt.Errorf("expected 1 headroom, got %d", len(headrooms))
+						}
+						cpu, memory := spotinst.IntValue(headrooms[0].CPUPerUnit), spotinst.IntValue(headrooms[0].MemoryPerUnit)
+						if cpu != 512 || memory != 1024 {
+							return fmt.Errorf("expected a headroom of 512 CPU units and 1024 MiB, got %d and %d", cpu, memory)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: createOceanECSLaunchSpecTerraform(&ECSLaunchSpecConfigMetadata{
+					oceanID:              oceanID,
+					name:                 launchSpecName,
+					updateBaselineFields: true}, testTaskDefinitionHeadroomOceanECSLaunchSpecConfig_DoesNotFit),
+				ExpectError: regexp.MustCompile("cannot be placed on any instance type"),
+			},
+		},
+	})
+}
+
+const testTaskDefinitionHeadroomOceanECSLaunchSpecConfig_Create = `
+resource "` + string(commons.OceanECSLaunchSpecResourceName) + `" "%v" {
+  provider = "%v"
+  name = "%v"
+  ocean_id = "%v"
+
+  user_data = "hello world"
+  image_id = "ami-05a68f290aa68e8f0"
+  security_group_ids = ["sg-a22000e8"]
+  iam_instance_profile = "ecsInstanceRole"
+  instance_types = ["c5.large", "c5.xlarge"]
+
+  attributes {
+    key   = "team"
+    value = "web"
+  }
+
+  autoscale_headrooms {
+    num_of_units = 2
+    task_definition = jsonencode({
+      cpu    = "512"
+      memory = "1024"
+      containerDefinitions = [{ name = "web", image = "nginx" }]
+      placementConstraints = [{ type = "memberOf", expression = "attribute:team == web" }]
+    })
+  }
+%v
+}
+
+`
+
+const testTaskDefinitionHeadroomOceanECSLaunchSpecConfig_DoesNotFit = `
+resource "` + string(commons.OceanECSLaunchSpecResourceName) + `" "%v" {
+  provider = "%v"
+  name = "%v"
+  ocean_id = "%v"
+
+  user_data = "hello world"
+  image_id = "ami-05a68f290aa68e8f0"
+  security_group_ids = ["sg-a22000e8"]
+  iam_instance_profile = "ecsInstanceRole"
+  instance_types = ["c5.large", "c5.xlarge"]
+
+  attributes {
+    key   = "team"
+    value = "web"
+  }
+
+  autoscale_headrooms {
+    num_of_units = 2
+    task_definition = jsonencode({
+      cpu    = "8 vCPU"
+      memory = "1024"
+      containerDefinitions = [{ name = "web", image = "nginx" }]
+    })
+  }
+%v
+}
+
+`
+
+//endregion
+
 // region OceanAWSLaunchSpec: Scheduling
 func TestAccSpotinstOceanECSLaunchSpec_Scheduling(t *testing.T) {
 	oceanID := "o-19711548"
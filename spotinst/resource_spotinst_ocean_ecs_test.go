@@ -708,7 +708,7 @@ func TestAccSpotinstOceanECS_Scheduling(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.time_windows.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:15:30"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.cron_expression", "testcron2"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.cron_expression", "0 2 * * *"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.is_enabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.task_type", "clusterRoll"),
 				),
@@ -729,7 +729,7 @@ func TestAccSpotinstOceanECS_Scheduling(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.time_windows.0", "Fri:15:30-Sat:13:30"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.shutdown_hours.0.time_windows.1", "Sun:15:30-Mon:13:30"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.cron_expression", "testcron"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.cron_expression", "0 3 * * *"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.is_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "scheduled_task.0.tasks.0.task_type", "clusterRoll"),
 				),
@@ -760,7 +760,7 @@ const testScheduling_Create = `
     }
     tasks {
       is_enabled = false
-      cron_expression = "testcron2"
+      cron_expression = "0 2 * * *"
       task_type = "clusterRoll"
     }
   }
@@ -776,7 +776,7 @@ const testScheduling_Update = `
     }
     tasks  {
       is_enabled = true
-      cron_expression = "testcron"
+      cron_expression = "0 3 * * *"
       task_type = "clusterRoll"
     }
   }
@@ -29,14 +29,22 @@ func resourceSpotinstOceanGKEImport() *schema.Resource {
 		ReadContext:   resourceSpotinstClusterGKEImportRead,
 		UpdateContext: resourceSpotinstClusterGKEImportUpdate,
 		DeleteContext: resourceSpotinstClusterGKEImportDelete,
+		CustomizeDiff: resourceSpotinstClusterGKEImportCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: commons.OceanGKEImportResource.GetSchemaMap(),
+
+		SchemaVersion:  commons.OceanGKEImportResource.GetSchemaVersion(),
+		StateUpgraders: commons.OceanGKEImportResource.GetStateUpgraders(),
 	}
 }
 
+func resourceSpotinstClusterGKEImportCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return ocean_gke_import.CustomizeDiff(diff)
+}
+
 func setupClusterGKEImportResource() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
@@ -705,3 +705,47 @@ const testStrategyGKEConfig_EmptyFields = `
 `
 
 // endregion
+
+// region State upgrade
+
+func TestOceanGKEImportStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		expected interface{}
+	}{
+		{
+			name:     "deprecated attribute",
+			rawState: map[string]interface{}{"id": "o-12345", "cluster_controller_id": "gke-cluster"},
+			expected: "gke-cluster",
+		},
+		{
+			name:     "both attributes",
+			rawState: map[string]interface{}{"id": "o-12345", "cluster_controller_id": "old", "controller_cluster_id": "new"},
+			expected: "new",
+		},
+		{
+			name:     "neither attribute",
+			rawState: map[string]interface{}{"id": "o-12345"},
+			expected: nil,
+		},
+	}
+
+	upgraders := resourceSpotinstOceanGKEImport().StateUpgraders
+	if len(upgraders) != 1 {
+		t.Fatalf("expected 1 upgrader, got %d", len(upgraders))
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			upgraded, err := upgraders[0].Upgrade(context.Background(), c.rawState, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if upgraded["controller_cluster_id"] != c.expected {
+				t.Errorf("expected %v, got %v", c.expected, upgraded["controller_cluster_id"])
+			}
+		})
+	}
+}
+
+// endregion
@@ -31,7 +31,7 @@ func resourceSpotinstOceanGKELaunchSpec() *schema.Resource {
 		DeleteContext: resourceSpotinstOceanGKELaunchSpecDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSpotinstOceanGKELaunchSpecImportState,
 		},
 
 		Schema: commons.OceanGKELaunchSpecResource.GetSchemaMap(),
@@ -48,6 +48,40 @@ func setupOceanGKELaunchSpecResource() {
 	commons.OceanGKELaunchSpecResource = commons.NewOceanGKELaunchSpecResource(fieldsMap)
 }
 
+// resourceSpotinstOceanGKELaunchSpecImportState supports importing a launch spec either by its ID
+// or by `<ocean_id>/<launch_spec_name>`.
+func resourceSpotinstOceanGKELaunchSpecImportState(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	oceanID, name, ok := commons.ParseOceanChildImportID(resourceData.Id())
+	if !ok {
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	input := &gcp.ListLaunchSpecsInput{OceanID: spotinst.String(oceanID)}
+	resp, err := meta.(*Client).ocean.CloudProviderGCP().ListLaunchSpecs(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list launchSpecs of cluster %q: %v", oceanID, err)
+	}
+
+	launchSpecs := make([]commons.NamedResource, 0, len(resp.LaunchSpecs))
+	for _, launchSpec := range resp.LaunchSpecs {
+		launchSpecs = append(launchSpecs, commons.NamedResource{
+			ID:   spotinst.StringValue(launchSpec.ID),
+			Name: spotinst.StringValue(launchSpec.Name),
+		})
+	}
+
+	id, err := commons.ResolveIDByName("launchSpec", oceanID, name, launchSpecs)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceData.SetId(id)
+	if err := resourceData.Set(string(ocean_gke_launch_spec.OceanId), oceanID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{resourceData}, nil
+}
+
 func resourceSpotinstOceanGKELaunchSpecCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf(string(commons.ResourceOnCreate), commons.OceanGKELaunchSpecResource.GetName())
 
@@ -0,0 +1,324 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/organization_policy_attachment"
+)
+
+func resourceOrgPolicyAttachment() *schema.Resource {
+	setupOrgPolicyAttachment()
+
+	return &schema.Resource{
+		CreateContext: resourceOrgPolicyAttachmentCreate,
+		ReadContext:   resourceOrgPolicyAttachmentRead,
+		UpdateContext: resourceOrgPolicyAttachmentUpdate,
+		DeleteContext: resourceOrgPolicyAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrgPolicyAttachmentImport,
+		},
+
+		Schema: commons.OrgPolicyAttachmentResource.GetSchemaMap(),
+	}
+}
+
+func setupOrgPolicyAttachment() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	organization_policy_attachment.Setup(fieldsMap)
+
+	commons.OrgPolicyAttachmentResource = commons.NewOrgPolicyAttachmentResource(fieldsMap)
+}
+
+func resourceOrgPolicyAttachmentCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.OrgPolicyAttachmentResource.GetName())
+
+	attachment, err := commons.OrgPolicyAttachmentResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyID := spotinst.StringValue(attachment.PolicyID)
+	id := orgPolicyAttachmentPrincipalID(attachment) + orgMappingIDSeparator + policyID
+
+	// An existing mapping belongs to whoever created it, so its accounts are
+	// not taken over.
+	attached := false
+	err = updateOrgPolicyMappings(ctx, attachment, meta, func(policies []*organization.UserPolicy) []*organization.UserPolicy {
+		if findOrgUserPolicy(policies, policyID) != nil {
+			attached = true
+			return nil
+		}
+		return append(policies, &organization.UserPolicy{
+			PolicyId:   attachment.PolicyID,
+			AccountIds: attachment.AccountIDs,
+		})
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to attach policy %s to %s: %s", policyID, orgPolicyAttachmentPrincipal(attachment), err)
+	}
+	if attached {
+		return diag.Errorf("[ERROR] policy %s is already attached to %s, import it with ID %q to manage it with this resource",
+			policyID, orgPolicyAttachmentPrincipal(attachment), id)
+	}
+
+	resourceData.SetId(id)
+	log.Printf("===> Policy attachment created successfully: %s <===", resourceData.Id())
+
+	return resourceOrgPolicyAttachmentRead(ctx, resourceData, meta)
+}
+
+func resourceOrgPolicyAttachmentRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.OrgPolicyAttachmentResource.GetName(), id)
+
+	principalID, policyID, err := parseOrgMappingID(id, "user_group_id|user_id", "policy_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attachment := &commons.OrgPolicyAttachment{PolicyID: spotinst.String(policyID)}
+	if _, ok := resourceData.GetOk(string(organization_policy_attachment.UserGroupID)); ok {
+		attachment.UserGroupID = spotinst.String(principalID)
+	} else {
+		attachment.UserID = spotinst.String(principalID)
+	}
+
+	policies, found, err := readOrgPolicyMappings(ctx, attachment, meta)
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to read policies of %s: %s", orgPolicyAttachmentPrincipal(attachment), err)
+	}
+
+	// If the user or group is gone or the policy was detached, so is the
+	// attachment.
+	policy := findOrgUserPolicy(policies, policyID)
+	if !found || policy == nil {
+		resourceData.SetId("")
+		return nil
+	}
+	attachment.AccountIDs = policy.AccountIds
+
+	if err := commons.OrgPolicyAttachmentResource.OnRead(attachment, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> Policy attachment read successfully: %s <===", id)
+	return nil
+}
+
+func resourceOrgPolicyAttachmentUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnUpdate),
+		commons.OrgPolicyAttachmentResource.GetName(), id)
+
+	shouldUpdate, _, err := commons.OrgPolicyAttachmentResource.OnUpdate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if shouldUpdate {
+		attachment, err := commons.OrgPolicyAttachmentResource.OnCreate(resourceData, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		policyID := spotinst.StringValue(attachment.PolicyID)
+		err = updateOrgPolicyMappings(ctx, attachment, meta, func(policies []*organization.UserPolicy) []*organization.UserPolicy {
+			policy := findOrgUserPolicy(policies, policyID)
+			if policy == nil {
+				policy = &organization.UserPolicy{PolicyId: attachment.PolicyID}
+				policies = append(policies, policy)
+			}
+			policy.AccountIds = attachment.AccountIDs
+			return policies
+		})
+		if err != nil {
+			return diag.Errorf("[ERROR] failed to update policy %s of %s: %s", policyID, orgPolicyAttachmentPrincipal(attachment), err)
+		}
+	}
+
+	log.Printf("===> Policy attachment updated successfully: %s <===", id)
+	return resourceOrgPolicyAttachmentRead(ctx, resourceData, meta)
+}
+
+func resourceOrgPolicyAttachmentDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.OrgPolicyAttachmentResource.GetName(), id)
+
+	attachment, err := commons.OrgPolicyAttachmentResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policyID := spotinst.StringValue(attachment.PolicyID)
+	err = updateOrgPolicyMappings(ctx, attachment, meta, func(policies []*organization.UserPolicy) []*organization.UserPolicy {
+		for i, policy := range policies {
+			if spotinst.StringValue(policy.PolicyId) == policyID {
+				return append(policies[:i], policies[i+1:]...)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to detach policy %s from %s: %s", policyID, orgPolicyAttachmentPrincipal(attachment), err)
+	}
+
+	// The API drops an empty list of policies from the request, so detaching
+	// the last policy of a user or group leaves it attached. Check that the
+	// policy is gone rather than dropping an attachment that still grants its
+	// permissions.
+	policies, found, err := readOrgPolicyMappings(ctx, attachment, meta)
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to read policies of %s: %s", orgPolicyAttachmentPrincipal(attachment), err)
+	}
+	if found && findOrgUserPolicy(policies, policyID) != nil {
+		return diag.Errorf("[ERROR] policy %s is still attached to %s; the last policy of a user or user group cannot be detached, attach another policy or delete the %s first",
+			policyID, orgPolicyAttachmentPrincipal(attachment), orgPolicyAttachmentPrincipalKind(attachment))
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+// resourceOrgPolicyAttachmentImport accepts <user_group_id>:<policy_id> and
+// <user_id>:<policy_id>, and finds out which of the two it was given.
+func resourceOrgPolicyAttachmentImport(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	principalID, _, err := parseOrgMappingID(resourceData.Id(), "user_group_id|user_id", "policy_id")
+	if err != nil {
+		return nil, err
+	}
+
+	userGroup, err := readOrgUserGroupIfExists(ctx, principalID, meta)
+	if err != nil {
+		return nil, err
+	}
+	if userGroup != nil {
+		if err := resourceData.Set(string(organization_policy_attachment.UserGroupID), principalID); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{resourceData}, nil
+	}
+
+	user, err := readOrgUserIfExists(ctx, principalID, meta)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("no user or user group with ID %s", principalID)
+	}
+	if err := resourceData.Set(string(organization_policy_attachment.UserID), principalID); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{resourceData}, nil
+}
+
+// readOrgPolicyMappings returns the policies of the user or user group of an
+// attachment, and whether the user or group exists.
+func readOrgPolicyMappings(ctx context.Context, attachment *commons.OrgPolicyAttachment, meta interface{}) ([]*organization.UserPolicy, bool, error) {
+	if attachment.UserGroupID != nil {
+		userGroup, err := readOrgUserGroupIfExists(ctx, spotinst.StringValue(attachment.UserGroupID), meta)
+		if err != nil || userGroup == nil {
+			return nil, false, err
+		}
+		policies := make([]*organization.UserPolicy, 0, len(userGroup.Policies))
+		for _, policy := range userGroup.Policies {
+			policies = append(policies, &organization.UserPolicy{
+				PolicyId:   policy.PolicyId,
+				AccountIds: policy.AccountIds,
+			})
+		}
+		return policies, true, nil
+	}
+
+	user, err := readOrgUserIfExists(ctx, spotinst.StringValue(attachment.UserID), meta)
+	if err != nil || user == nil {
+		return nil, false, err
+	}
+	policies := make([]*organization.UserPolicy, 0, len(user.Policies))
+	for _, policy := range user.Policies {
+		policies = append(policies, &organization.UserPolicy{
+			PolicyId:   policy.PolicyId,
+			AccountIds: policy.AccountIds,
+		})
+	}
+	return policies, true, nil
+}
+
+// updateOrgPolicyMappings replaces the policies of the user or user group of
+// an attachment with the ones returned by build, which returns nil when
+// there is nothing to change. The API only sets the policies of a user or
+// group as a whole, so changes are serialized per user or group.
+func updateOrgPolicyMappings(ctx context.Context, attachment *commons.OrgPolicyAttachment, meta interface{},
+	build func(policies []*organization.UserPolicy) []*organization.UserPolicy) error {
+
+	principalID := orgPolicyAttachmentPrincipalID(attachment)
+	commons.ResourceMutexKV.Lock(principalID)
+	defer commons.ResourceMutexKV.Unlock(principalID)
+
+	policies, found, err := readOrgPolicyMappings(ctx, attachment, meta)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%s not found", orgPolicyAttachmentPrincipal(attachment))
+	}
+
+	policies = build(policies)
+	if policies == nil {
+		return nil
+	}
+	if attachment.UserGroupID != nil {
+		return updatePolicyMappingOfUserGroup(policies, attachment.UserGroupID, meta.(*Client))
+	}
+	return updatePolicyMapping(policies, attachment.UserID, meta.(*Client))
+}
+
+func readOrgUserIfExists(ctx context.Context, userID string, meta interface{}) (*organization.User, error) {
+	input := &organization.ReadUserInput{UserID: spotinst.String(userID)}
+	resp, err := meta.(*Client).organization.ReadUser(ctx, input)
+	if err != nil {
+		if commons.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+func findOrgUserPolicy(policies []*organization.UserPolicy, policyID string) *organization.UserPolicy {
+	for _, policy := range policies {
+		if spotinst.StringValue(policy.PolicyId) == policyID {
+			return policy
+		}
+	}
+	return nil
+}
+
+func orgPolicyAttachmentPrincipalID(attachment *commons.OrgPolicyAttachment) string {
+	if attachment.UserGroupID != nil {
+		return spotinst.StringValue(attachment.UserGroupID)
+	}
+	return spotinst.StringValue(attachment.UserID)
+}
+
+func orgPolicyAttachmentPrincipalKind(attachment *commons.OrgPolicyAttachment) string {
+	if attachment.UserGroupID != nil {
+		return "user group"
+	}
+	return "user"
+}
+
+func orgPolicyAttachmentPrincipal(attachment *commons.OrgPolicyAttachment) string {
+	return orgPolicyAttachmentPrincipalKind(attachment) + " " + orgPolicyAttachmentPrincipalID(attachment)
+}
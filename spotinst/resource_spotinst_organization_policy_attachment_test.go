@@ -0,0 +1,173 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOrganizationPolicyAttachmentResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OrgPolicyAttachmentResourceName), name)
+}
+
+// testOrganizationPolicyAttachmentFromState returns the attachment described
+// by the state of a policy attachment resource.
+func testOrganizationPolicyAttachmentFromState(rs *terraform.ResourceState) (*commons.OrgPolicyAttachment, error) {
+	principalID, policyID, err := parseOrgMappingID(rs.Primary.ID, "user_group_id|user_id", "policy_id")
+	if err != nil {
+		return nil, err
+	}
+	attachment := &commons.OrgPolicyAttachment{PolicyID: spotinst.String(policyID)}
+	if rs.Primary.Attributes["user_group_id"] != "" {
+		attachment.UserGroupID = spotinst.String(principalID)
+	} else {
+		attachment.UserID = spotinst.String(principalID)
+	}
+	return attachment, nil
+}
+
+func testOrganizationPolicyAttachmentDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OrgPolicyAttachmentResourceName) {
+			continue
+		}
+		attachment, err := testOrganizationPolicyAttachmentFromState(rs)
+		if err != nil {
+			return err
+		}
+		policies, found, err := readOrgPolicyMappings(context.Background(), attachment, client)
+		if err != nil || !found {
+			continue
+		}
+		if findOrgUserPolicy(policies, spotinst.StringValue(attachment.PolicyID)) != nil {
+			return fmt.Errorf("policy attachment still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckOrganizationPolicyAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		attachment, err := testOrganizationPolicyAttachmentFromState(rs)
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		policies, found, err := readOrgPolicyMappings(context.Background(), attachment, client)
+		if err != nil {
+			return err
+		}
+		if !found || findOrgUserPolicy(policies, spotinst.StringValue(attachment.PolicyID)) == nil {
+			return fmt.Errorf("policy attachment not found: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func createOrganizationPolicyAttachmentTerraform(tfResource string, resourceName string) string {
+	template := fmt.Sprintf(tfResource, resourceName, resourceName, resourceName)
+
+	log.Printf("Terraform [%v] template:\n%v", resourceName, template)
+	return template
+}
+
+// region Organization: Policy Attachment
+func TestAccSpotinstOrganization_PolicyAttachment(t *testing.T) {
+	name := "terraform-policy-attachment"
+	resourceName := createOrganizationPolicyAttachmentResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOrganizationPolicyAttachmentDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOrganizationPolicyAttachmentTerraform(testOrganization_Policy_Attachment_Create, name),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOrganizationPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_id", "pol-5479db5e"),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+				),
+			},
+			{
+				Config: createOrganizationPolicyAttachmentTerraform(testOrganization_Policy_Attachment_Update, name),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOrganizationPolicyAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testOrganization_Policy_Attachment_Create = `
+resource "` + string(commons.OrgUserGroupResourceName) + `" "%v" {
+  provider    = "aws"
+  name        = "terraform_policy_attachment"
+  description = "user group by terraform"
+
+  # The last policy of a group cannot be detached, so another one stays.
+  policies {
+    account_ids = ["act-e2be553a"]
+    policy_id   = "pol-467f634c"
+  }
+
+  lifecycle {
+    ignore_changes = [policies]
+  }
+}
+
+resource "` + string(commons.OrgPolicyAttachmentResourceName) + `" "%v" {
+  provider      = "aws"
+  user_group_id = ` + string(commons.OrgUserGroupResourceName) + `.%v.id
+  policy_id     = "pol-5479db5e"
+  account_ids   = ["act-75eb3ba3"]
+}
+`
+
+const testOrganization_Policy_Attachment_Update = `
+resource "` + string(commons.OrgUserGroupResourceName) + `" "%v" {
+  provider    = "aws"
+  name        = "terraform_policy_attachment"
+  description = "user group by terraform"
+
+  # The last policy of a group cannot be detached, so another one stays.
+  policies {
+    account_ids = ["act-e2be553a"]
+    policy_id   = "pol-467f634c"
+  }
+
+  lifecycle {
+    ignore_changes = [policies]
+  }
+}
+
+resource "` + string(commons.OrgPolicyAttachmentResourceName) + `" "%v" {
+  provider      = "aws"
+  user_group_id = ` + string(commons.OrgUserGroupResourceName) + `.%v.id
+  policy_id     = "pol-5479db5e"
+  account_ids   = ["act-75eb3ba3", "act-e2be553a"]
+}
+`
+
+// endregion
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
 	organizationPackage "github.com/spotinst/terraform-provider-spotinst/spotinst/organization_programmatic_user"
@@ -22,6 +23,7 @@ func resourceOrgProgrammaticUser() *schema.Resource {
 		UpdateContext: resourceOrgProgrammaticUserUpdate,
 		ReadContext:   resourceOrgProgrammaticUserRead,
 		DeleteContext: resourceOrgProgrammaticUserDelete,
+		CustomizeDiff: resourceOrgProgrammaticUserCustomizeDiff,
 
 		Schema: commons.OrgProgrammaticUserResource.GetSchemaMap(),
 	}
@@ -92,10 +94,11 @@ func resourceOrgProgrammaticUserCreate(ctx context.Context, resourceData *schema
 		return diag.FromErr(err)
 	}
 
-	userId, err := createProgrammaticUser(programmaticUser, meta.(*Client))
+	createdUser, err := createProgrammaticUser(programmaticUser, meta.(*Client))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	userId := createdUser.ProgUserId
 
 	var updateErr error = nil
 
@@ -108,18 +111,62 @@ func resourceOrgProgrammaticUserCreate(ctx context.Context, resourceData *schema
 	}
 
 	resourceData.SetId(spotinst.StringValue(userId))
+	if err := resourceData.Set(string(organizationPackage.Token), spotinst.StringValue(createdUser.Token)); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(organizationPackage.Token), err)
+	}
+	if err := resourceData.Set(string(organizationPackage.TokenCreatedAt), time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return diag.Errorf(string(commons.FailureFieldReadPattern), string(organizationPackage.TokenCreatedAt), err)
+	}
 	log.Printf("===> User created successfully: %s <===", resourceData.Id())
 
 	return resourceOrgProgrammaticUserRead(ctx, resourceData, meta)
 }
 
-func createProgrammaticUser(userObj *organization.ProgrammaticUser, spotinstClient *Client) (*string, error) {
+func createProgrammaticUser(userObj *organization.ProgrammaticUser, spotinstClient *Client) (*organization.ProgrammaticUser, error) {
 	input := userObj
 	resp, err := spotinstClient.organization.CreateProgUser(context.Background(), input)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] failed to create user: %s", err)
 	}
-	return resp.ProgrammaticUser.ProgUserId, nil
+	return resp.ProgrammaticUser, nil
+}
+
+// resourceOrgProgrammaticUserCustomizeDiff plans the replacement of the user
+// once its token is older than rotate_after_days. The API cannot regenerate
+// the token of an existing user, so rotating means creating a new user.
+func resourceOrgProgrammaticUserCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	days, ok := diff.GetOk(string(organizationPackage.RotateAfterDays))
+	if !ok {
+		return nil
+	}
+
+	// Read seeds token_created_at, so it is only empty when the plan was
+	// made without refreshing a user created by an older provider version.
+	createdAt, ok := diff.GetOk(string(organizationPackage.TokenCreatedAt))
+	if !ok {
+		log.Printf("[WARN] %s of user %s is unknown, not checking %s until the next refresh",
+			organizationPackage.TokenCreatedAt, diff.Id(), organizationPackage.RotateAfterDays)
+		return nil
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt.(string))
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %s", organizationPackage.TokenCreatedAt, createdAt, err)
+	}
+
+	if time.Since(created) < time.Duration(days.(int))*24*time.Hour {
+		return nil
+	}
+
+	log.Printf("===> Token of user %s was created at %s, planning rotation <===", diff.Id(), createdAt)
+	if err := diff.SetNewComputed(string(organizationPackage.Token)); err != nil {
+		return err
+	}
+	return diff.ForceNew(string(organizationPackage.Token))
 }
 
 func resourceOrgProgrammaticUserUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/spotinst/spotinst-sdk-go/service/organization"
 
@@ -88,6 +89,8 @@ func TestAccSpotinstOrganization_ProgrammaticUser_WithAccounts(t *testing.T) {
 					resource.TestCheckResourceAttr(progUserResourceName, "accounts.0.account_role", "viewer"),
 					resource.TestCheckResourceAttr(progUserResourceName, "accounts.1.account_id", "act-e2be553a"),
 					resource.TestCheckResourceAttr(progUserResourceName, "accounts.1.account_role", "viewer"),
+					resource.TestCheckResourceAttrSet(progUserResourceName, "token"),
+					resource.TestCheckResourceAttrSet(progUserResourceName, "token_created_at"),
 				),
 			},
 		},
@@ -216,3 +219,44 @@ resource "` + string(commons.OrgProgrammaticUserResourceName) + `" "%v" {
 
 }
 `
+
+func TestOrganizationProgrammaticUserTokenCreatedAtRead(t *testing.T) {
+	res := resourceOrgProgrammaticUser()
+	user := &organization.ProgrammaticUser{ProgUserId: spotinst.String("pu-12345678"), Name: spotinst.String("terraform")}
+
+	cases := []struct {
+		name      string
+		createdAt string
+		seeded    bool
+	}{
+		{"seeded when missing", "", true},
+		{"kept when set", "2024-01-02T03:04:05Z", false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resourceData := res.Data(&terraform.InstanceState{
+				ID:         "pu-12345678",
+				Attributes: map[string]string{"token_created_at": c.createdAt},
+			})
+			before := time.Now().UTC().Truncate(time.Second)
+			if err := commons.OrgProgrammaticUserResource.OnRead(user, resourceData, nil); err != nil {
+				t.Fatal(err)
+			}
+
+			got := resourceData.Get("token_created_at").(string)
+			if !c.seeded {
+				if got != c.createdAt {
+					t.Errorf("expected %q to be kept, got %q", c.createdAt, got)
+				}
+				return
+			}
+			created, err := time.Parse(time.RFC3339, got)
+			if err != nil {
+				t.Fatalf("expected an RFC 3339 time, got %q: %v", got, err)
+			}
+			if created.Before(before) || created.After(time.Now().UTC()) {
+				t.Errorf("expected the time of the read, got %s", created)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/spotinst/spotinst-sdk-go/service/organization"
+	"github.com/spotinst/spotinst-sdk-go/spotinst"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/organization_user_group_membership"
+)
+
+// orgMappingIDSeparator separates the parts of the IDs of user group
+// memberships and policy attachments.
+const orgMappingIDSeparator = ":"
+
+func resourceOrgUserGroupMembership() *schema.Resource {
+	setupOrgUserGroupMembership()
+
+	return &schema.Resource{
+		CreateContext: resourceOrgUserGroupMembershipCreate,
+		ReadContext:   resourceOrgUserGroupMembershipRead,
+		DeleteContext: resourceOrgUserGroupMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: commons.OrgUserGroupMembershipResource.GetSchemaMap(),
+	}
+}
+
+func setupOrgUserGroupMembership() {
+	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
+
+	organization_user_group_membership.Setup(fieldsMap)
+
+	commons.OrgUserGroupMembershipResource = commons.NewOrgUserGroupMembershipResource(fieldsMap)
+}
+
+func resourceOrgUserGroupMembershipCreate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf(string(commons.ResourceOnCreate),
+		commons.OrgUserGroupMembershipResource.GetName())
+
+	membership, err := commons.OrgUserGroupMembershipResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupID := spotinst.StringValue(membership.UserGroupID)
+	userID := spotinst.StringValue(membership.UserID)
+
+	err = updateOrgUserGroupUsers(ctx, groupID, meta, func(userIDs []string) []string {
+		if slices.Contains(userIDs, userID) {
+			return nil
+		}
+		return append(userIDs, userID)
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to add user %s to user group %s: %s", userID, groupID, err)
+	}
+
+	resourceData.SetId(groupID + orgMappingIDSeparator + userID)
+	log.Printf("===> User group membership created successfully: %s <===", resourceData.Id())
+
+	return resourceOrgUserGroupMembershipRead(ctx, resourceData, meta)
+}
+
+func resourceOrgUserGroupMembershipRead(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnRead),
+		commons.OrgUserGroupMembershipResource.GetName(), id)
+
+	groupID, userID, err := parseOrgMappingID(id, "user_group_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userGroup, err := readOrgUserGroupIfExists(ctx, groupID, meta)
+	if err != nil {
+		return diag.Errorf("[ERROR] Failed to read User Group: %s", err)
+	}
+
+	// If the group is gone or the user left it, so is the membership.
+	if userGroup == nil || !slices.Contains(orgUserGroupUserIDs(userGroup), userID) {
+		resourceData.SetId("")
+		return nil
+	}
+
+	membership := &commons.OrgUserGroupMembership{
+		UserGroupID: spotinst.String(groupID),
+		UserID:      spotinst.String(userID),
+	}
+	if err := commons.OrgUserGroupMembershipResource.OnRead(membership, resourceData, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("===> User group membership read successfully: %s <===", id)
+	return nil
+}
+
+func resourceOrgUserGroupMembershipDelete(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	id := resourceData.Id()
+	log.Printf(string(commons.ResourceOnDelete),
+		commons.OrgUserGroupMembershipResource.GetName(), id)
+
+	groupID, userID, err := parseOrgMappingID(id, "user_group_id", "user_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateOrgUserGroupUsers(ctx, groupID, meta, func(userIDs []string) []string {
+		if !slices.Contains(userIDs, userID) {
+			return nil
+		}
+		return slices.DeleteFunc(userIDs, func(id string) bool { return id == userID })
+	})
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to remove user %s from user group %s: %s", userID, groupID, err)
+	}
+
+	// The API drops an empty list of users from the request, so removing the
+	// last member of a group leaves it in place. Check that the user is gone
+	// rather than dropping a membership that still exists.
+	userGroup, err := readOrgUserGroupIfExists(ctx, groupID, meta)
+	if err != nil {
+		return diag.Errorf("[ERROR] failed to read user group %s: %s", groupID, err)
+	}
+	if userGroup != nil && slices.Contains(orgUserGroupUserIDs(userGroup), userID) {
+		return diag.Errorf("[ERROR] user %s is still a member of user group %s; the last member of a group cannot be removed, add another member or delete the group first", userID, groupID)
+	}
+
+	resourceData.SetId("")
+	return nil
+}
+
+// updateOrgUserGroupUsers replaces the users of a user group with the ones
+// returned by build, which returns nil when there is nothing to change. The
+// API only sets the users of a group as a whole, so changes are serialized
+// per group to keep concurrent memberships from overwriting each other.
+func updateOrgUserGroupUsers(ctx context.Context, groupID string, meta interface{}, build func(userIDs []string) []string) error {
+	commons.ResourceMutexKV.Lock(groupID)
+	defer commons.ResourceMutexKV.Unlock(groupID)
+
+	userGroup, err := readOrgUserGroupIfExists(ctx, groupID, meta)
+	if err != nil {
+		return err
+	}
+	if userGroup == nil {
+		return fmt.Errorf("user group %s not found", groupID)
+	}
+
+	userIDs := build(orgUserGroupUserIDs(userGroup))
+	if userIDs == nil {
+		return nil
+	}
+	return updateUserIdsMapping(userIDs, spotinst.String(groupID), meta.(*Client))
+}
+
+func readOrgUserGroupIfExists(ctx context.Context, groupID string, meta interface{}) (*organization.UserGroup, error) {
+	input := &organization.ReadUserGroupInput{UserGroupID: spotinst.String(groupID)}
+	resp, err := meta.(*Client).organization.ReadUserGroup(ctx, input)
+	if err != nil {
+		if commons.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return resp.UserGroup, nil
+}
+
+// orgUserGroupUserIDs returns the IDs of the users of a group. Groups list
+// their users with details; userIds is only set in requests.
+func orgUserGroupUserIDs(userGroup *organization.UserGroup) []string {
+	userIDs := make([]string, 0, len(userGroup.Users))
+	for _, user := range userGroup.Users {
+		if user.UserId != nil {
+			userIDs = append(userIDs, spotinst.StringValue(user.UserId))
+		}
+	}
+	for _, userID := range userGroup.UserIds {
+		if !slices.Contains(userIDs, userID) {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+// parseOrgMappingID splits the ID of a user group membership or a policy
+// attachment into its two parts, named in the error message.
+func parseOrgMappingID(id, first, second string) (string, string, error) {
+	parts := strings.SplitN(id, orgMappingIDSeparator, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected <%s>%s<%s>",
+			id, first, orgMappingIDSeparator, second)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,131 @@
+package spotinst
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
+)
+
+func createOrganizationUserGroupMembershipResourceName(name string) string {
+	return fmt.Sprintf("%v.%v", string(commons.OrgUserGroupMembershipResourceName), name)
+}
+
+func testOrganizationUserGroupMembershipDestroy(s *terraform.State) error {
+	client := testAccProviderAWS.Meta().(*Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != string(commons.OrgUserGroupMembershipResourceName) {
+			continue
+		}
+		groupID, userID, err := parseOrgMappingID(rs.Primary.ID, "user_group_id", "user_id")
+		if err != nil {
+			return err
+		}
+		userGroup, err := readOrgUserGroupIfExists(context.Background(), groupID, client)
+		if err != nil || userGroup == nil {
+			continue
+		}
+		if slices.Contains(orgUserGroupUserIDs(userGroup), userID) {
+			return fmt.Errorf("user group membership still exists")
+		}
+	}
+	return nil
+}
+
+func testCheckOrganizationUserGroupMembershipExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no resource ID is set")
+		}
+		groupID, userID, err := parseOrgMappingID(rs.Primary.ID, "user_group_id", "user_id")
+		if err != nil {
+			return err
+		}
+		client := testAccProviderAWS.Meta().(*Client)
+		userGroup, err := readOrgUserGroupIfExists(context.Background(), groupID, client)
+		if err != nil {
+			return err
+		}
+		if userGroup == nil || !slices.Contains(orgUserGroupUserIDs(userGroup), userID) {
+			return fmt.Errorf("user group membership not found: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func createOrganizationUserGroupMembershipTerraform(tfResource string, resourceName string) string {
+	template := fmt.Sprintf(tfResource, resourceName)
+
+	log.Printf("Terraform [%v] template:\n%v", resourceName, template)
+	return template
+}
+
+// region Organization: User Group Membership
+func TestAccSpotinstOrganization_UserGroupMembership(t *testing.T) {
+	name := "terraform-user-group-membership"
+	resourceName := createOrganizationUserGroupMembershipResourceName(name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testOrganizationUserGroupMembershipDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createOrganizationUserGroupMembershipTerraform(testOrganization_User_Group_Membership_Create, name),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckOrganizationUserGroupMembershipExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_id", "u-429562d5"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_group_id",
+						createOrganizationUserGroupResourceName(name), "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+const testOrganization_User_Group_Membership_Create = `
+resource "` + string(commons.OrgProgrammaticUserResourceName) + `" "%[1]v" {
+  provider    = "aws"
+  name        = "terraform_user_group_membership"
+  description = "keeps the user group non-empty"
+
+  accounts {
+    account_id   = "act-75eb3ba3"
+    account_role = "viewer"
+  }
+}
+
+resource "` + string(commons.OrgUserGroupResourceName) + `" "%[1]v" {
+  provider    = "aws"
+  name        = "terraform_user_group_membership"
+  description = "user group by terraform"
+  user_ids    = [` + string(commons.OrgProgrammaticUserResourceName) + `.%[1]v.id]
+
+  lifecycle {
+    ignore_changes = [user_ids]
+  }
+}
+
+resource "` + string(commons.OrgUserGroupMembershipResourceName) + `" "%[1]v" {
+  provider      = "aws"
+  user_group_id = ` + string(commons.OrgUserGroupResourceName) + `.%[1]v.id
+  user_id       = "u-429562d5"
+}
+`
+
+// endregion
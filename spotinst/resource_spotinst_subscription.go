@@ -22,11 +22,20 @@ func resourceSpotinstSubscription() *schema.Resource {
 		UpdateContext: resourceSpotinstSubscriptionUpdate,
 		ReadContext:   resourceSpotinstSubscriptionRead,
 		DeleteContext: resourceSpotinstSubscriptionDelete,
+		CustomizeDiff: resourceSpotinstSubscriptionCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: commons.SubscriptionResource.GetSchemaMap(),
 	}
 }
 
+func resourceSpotinstSubscriptionCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return subscriptionPackage.CustomizeDiff(diff)
+}
+
 func setupSubscription() {
 	fieldsMap := make(map[commons.FieldName]*commons.GenericField)
 
@@ -40,9 +49,20 @@ func resourceSpotinstSubscriptionDelete(ctx context.Context, resourceData *schem
 	log.Printf(string(commons.ResourceOnDelete),
 		commons.SubscriptionResource.GetName(), id)
 
-	input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(id)}
-	if _, err := meta.(*Client).subscription.Delete(context.Background(), input); err != nil {
-		return diag.Errorf("[ERROR] Failed to delete subscription: %s", err)
+	// The subscriptions of the additional endpoints go first, the resource
+	// ID is the subscription of the first one.
+	endpoints, _ := subscriptionPackage.ExpandEndpoints(resourceData.Get(string(subscriptionPackage.Endpoints)))
+	for i, endpoint := range endpoints {
+		if i == 0 || endpoint.SubscriptionID == "" {
+			continue
+		}
+		if err := deleteSubscription(endpoint.SubscriptionID, meta.(*Client)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := deleteSubscription(id, meta.(*Client)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	resourceData.SetId("")
@@ -71,6 +91,16 @@ func resourceSpotinstSubscriptionRead(ctx context.Context, resourceData *schema.
 	if err := commons.SubscriptionResource.OnRead(sub, resourceData, meta); err != nil {
 		return diag.FromErr(err)
 	}
+
+	if v, ok := resourceData.GetOk(string(subscriptionPackage.Endpoints)); ok {
+		endpoints, err := readEndpoints(sub, v, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := resourceData.Set(string(subscriptionPackage.Endpoints), subscriptionPackage.FlattenEndpoints(endpoints)); err != nil {
+			return diag.Errorf(string(commons.FailureFieldReadPattern), string(subscriptionPackage.Endpoints), err)
+		}
+	}
 	log.Printf("===> Subscription read successfully: %s <===", id)
 	return nil
 }
@@ -84,12 +114,33 @@ func resourceSpotinstSubscriptionCreate(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
-	subscriptionId, err := createSubscription(sub, meta.(*Client))
+	endpoints, err := subscriptionPackage.ExpandEndpoints(resourceData.Get(string(subscriptionPackage.Endpoints)))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	resourceData.SetId(spotinst.StringValue(subscriptionId))
+	if len(endpoints) == 0 {
+		subscriptionId, err := createSubscription(sub, meta.(*Client))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resourceData.SetId(spotinst.StringValue(subscriptionId))
+	} else {
+		// Every endpoint is a subscription of its own; the first one is the
+		// resource ID. The subscriptions created so far are kept in state
+		// when one fails, so they are deleted along with the resource.
+		err := createEndpointSubscriptions(sub, endpoints, meta.(*Client))
+		if endpoints[0].SubscriptionID != "" {
+			resourceData.SetId(endpoints[0].SubscriptionID)
+			if err := resourceData.Set(string(subscriptionPackage.Endpoints), subscriptionPackage.FlattenEndpoints(endpoints)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("===> Subscription created successfully: %s <===", resourceData.Id())
 
 	return resourceSpotinstSubscriptionRead(ctx, resourceData, meta)
@@ -104,6 +155,79 @@ func createSubscription(subObj *subscription.Subscription, spotinstClient *Clien
 	return resp.Subscription.ID, nil
 }
 
+func createEndpointSubscriptions(sub *subscription.Subscription, endpoints []*subscriptionPackage.NotificationEndpoint, spotinstClient *Client) error {
+	for _, endpoint := range endpoints {
+		subscriptionId, err := createSubscription(endpointSubscription(sub, endpoint), spotinstClient)
+		if err != nil {
+			return err
+		}
+		endpoint.SubscriptionID = spotinst.StringValue(subscriptionId)
+	}
+	return nil
+}
+
+// endpointSubscription returns a copy of sub that notifies endpoint.
+func endpointSubscription(sub *subscription.Subscription, endpoint *subscriptionPackage.NotificationEndpoint) *subscription.Subscription {
+	endpointSub := commons.NewSubscription()
+	endpointSub.SetResourceId(sub.ResourceID)
+	endpointSub.SetEventType(sub.EventType)
+	endpointSub.SetFormat(sub.Format)
+	endpointSub.SetProtocol(spotinst.String(endpoint.Protocol))
+	endpointSub.SetEndpoint(spotinst.String(endpoint.Address))
+	return endpointSub
+}
+
+// readEndpoints reads the subscriptions of the endpoints in state. sub is the
+// subscription of the first endpoint. Endpoints whose subscription no longer
+// exists are dropped, so that they are created again.
+func readEndpoints(sub *subscription.Subscription, data interface{}, spotinstClient *Client) ([]*subscriptionPackage.NotificationEndpoint, error) {
+	stateEndpoints, err := subscriptionPackage.ExpandEndpoints(data)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*subscriptionPackage.NotificationEndpoint, 0, len(stateEndpoints))
+	for i, endpoint := range stateEndpoints {
+		endpointSub := sub
+		if i > 0 {
+			if endpoint.SubscriptionID == "" {
+				continue
+			}
+			input := &subscription.ReadSubscriptionInput{SubscriptionID: spotinst.String(endpoint.SubscriptionID)}
+			resp, err := spotinstClient.subscription.Read(context.Background(), input)
+			if err != nil {
+				if commons.IsNotFoundError(err) {
+					log.Printf("[WARN] Subscription %s of endpoint %d not found", endpoint.SubscriptionID, i)
+					continue
+				}
+				return nil, fmt.Errorf("[ERROR] Failed to read subscription %s: %s", endpoint.SubscriptionID, err)
+			}
+			if resp.Subscription == nil {
+				continue
+			}
+			endpointSub = resp.Subscription
+		}
+
+		endpoints = append(endpoints, &subscriptionPackage.NotificationEndpoint{
+			SubscriptionID: spotinst.StringValue(endpointSub.ID),
+			Protocol:       spotinst.StringValue(endpointSub.Protocol),
+			Address:        spotinst.StringValue(endpointSub.Endpoint),
+		})
+	}
+	return endpoints, nil
+}
+
+func deleteSubscription(id string, spotinstClient *Client) error {
+	input := &subscription.DeleteSubscriptionInput{SubscriptionID: spotinst.String(id)}
+	if _, err := spotinstClient.subscription.Delete(context.Background(), input); err != nil {
+		if commons.IsNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Failed to delete subscription %s: %s", id, err)
+	}
+	return nil
+}
+
 func resourceSpotinstSubscriptionUpdate(ctx context.Context, resourceData *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	id := resourceData.Id()
 	log.Printf(string(commons.ResourceOnUpdate),
@@ -114,17 +238,102 @@ func resourceSpotinstSubscriptionUpdate(ctx context.Context, resourceData *schem
 		return diag.FromErr(err)
 	}
 
-	if shouldUpdate {
+	o, n := resourceData.GetChange(string(subscriptionPackage.Endpoints))
+	oldEndpoints, _ := subscriptionPackage.ExpandEndpoints(o)
+	newEndpoints, err := subscriptionPackage.ExpandEndpoints(n)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(newEndpoints) == 0 && shouldUpdate {
 		sub.SetId(spotinst.String(id))
 		if err := updateSubscription(sub, resourceData, meta); err != nil {
 			return diag.FromErr(err)
 		}
 	}
+	if len(newEndpoints) > 0 || len(oldEndpoints) > 0 {
+		if err := updateEndpointSubscriptions(resourceData, shouldUpdate, oldEndpoints, newEndpoints, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	log.Printf("===> Subscription updated successfully: %s <===", id)
 	return resourceSpotinstSubscriptionRead(ctx, resourceData, meta)
 }
 
+// updateEndpointSubscriptions updates the subscriptions of the endpoints
+// that changed, or of all endpoints when a shared field changed, creates the
+// subscriptions of new endpoints and deletes those of removed endpoints.
+// Endpoints are matched by position. The subscriptions of removed endpoints
+// that could not be deleted are kept in state, so that they are deleted on
+// the next apply.
+func updateEndpointSubscriptions(resourceData *schema.ResourceData, shouldUpdate bool,
+	oldEndpoints, newEndpoints []*subscriptionPackage.NotificationEndpoint, meta interface{}) error {
+
+	// The full subscription, since new endpoints are created from it.
+	sub, err := commons.SubscriptionResource.OnCreate(resourceData, meta)
+	if err != nil {
+		return err
+	}
+	spotinstClient := meta.(*Client)
+
+	changed := func(i int) bool {
+		return shouldUpdate || i >= len(oldEndpoints) ||
+			oldEndpoints[i].Protocol != newEndpoints[i].Protocol || oldEndpoints[i].Address != newEndpoints[i].Address
+	}
+	update := func(i int, id string) error {
+		if !changed(i) {
+			return nil
+		}
+		endpointSub := endpointSubscription(sub, newEndpoints[i])
+		endpointSub.SetId(spotinst.String(id))
+		input := &subscription.UpdateSubscriptionInput{Subscription: endpointSub}
+		if _, err := spotinstClient.subscription.Update(context.Background(), input); err != nil {
+			return fmt.Errorf("[ERROR] failed to update subscription %s: %s", id, err)
+		}
+		return nil
+	}
+
+	// The first endpoint is the resource itself.
+	if len(newEndpoints) > 0 {
+		if err := update(0, resourceData.Id()); err != nil {
+			return err
+		}
+	}
+
+	oldIDs := make([]string, len(oldEndpoints))
+	for i, endpoint := range oldEndpoints {
+		oldIDs[i] = endpoint.SubscriptionID
+	}
+	children := &commons.PositionalChildren{
+		Create: func(i int) (string, error) {
+			subscriptionId, err := createSubscription(endpointSubscription(sub, newEndpoints[i]), spotinstClient)
+			return spotinst.StringValue(subscriptionId), err
+		},
+		Update: update,
+		Delete: func(id string) error {
+			return deleteSubscription(id, spotinstClient)
+		},
+	}
+	ids, removed, syncErr := children.Sync(resourceData.Id(), oldIDs, len(newEndpoints))
+
+	endpoints := make([]*subscriptionPackage.NotificationEndpoint, 0, len(newEndpoints)+len(removed)+1)
+	for i, endpoint := range newEndpoints {
+		endpoint.SubscriptionID = ids[i]
+		endpoints = append(endpoints, endpoint)
+	}
+	if len(removed) > 0 && len(endpoints) == 0 {
+		endpoints = append(endpoints, oldEndpoints[0])
+	}
+	for _, i := range removed {
+		endpoints = append(endpoints, oldEndpoints[i])
+	}
+	if err := resourceData.Set(string(subscriptionPackage.Endpoints), subscriptionPackage.FlattenEndpoints(endpoints)); err != nil {
+		return err
+	}
+	return syncErr
+}
+
 func updateSubscription(sub *subscription.Subscription, resourceData *schema.ResourceData, meta interface{}) error {
 	input := &subscription.UpdateSubscriptionInput{
 		Subscription: sub,
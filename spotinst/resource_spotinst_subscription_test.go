@@ -429,3 +429,109 @@ resource "` + string(commons.SubscriptionResourceName) + `" "%v" {
 `
 
 // endregion
+
+// region Subscription: Endpoints
+func TestAccSpotinstSubscription_Endpoints(t *testing.T) {
+	subscriptionName := "subscription-endpoints"
+	subResourceName := createSubscriptionResourceName(subscriptionName)
+
+	groupName := "eg-baseline"
+	groupResourceName := createElastigroupResourceName(groupName)
+	groupResourceId := "${" + groupResourceName + ".id}"
+	groupTerraform := createElastigroupTerraform(&GroupConfigMetadata{groupName: groupName})
+
+	var group aws.Group
+	var sub subscription.Subscription
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t, "aws") },
+		Providers:    TestAccProviders,
+		CheckDestroy: testSubscriptionDestroy,
+
+		Steps: []resource.TestStep{
+			{
+				Config: createSubscriptionTerraform(testSubscription_Endpoints_Create, subscriptionName, groupResourceId, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					testCheckElastigroupAttributes(&group, groupName),
+
+					testCheckSubscriptionExists(&sub, subResourceName),
+					resource.TestCheckResourceAttr(subResourceName, "event_type", "GROUP_UPDATED"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoints.#", "2"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoints.0.email.0.address", "test@me.com"),
+					resource.TestCheckResourceAttrPair(subResourceName, "endpoints.0.subscription_id", subResourceName, "id"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoints.1.web.0.url", "https://test.me"),
+					resource.TestCheckResourceAttrSet(subResourceName, "endpoints.1.subscription_id"),
+					resource.TestCheckResourceAttr(subResourceName, "protocol", "email"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoint", "test@me.com"),
+					resource.TestCheckResourceAttr(subResourceName, "format_preview", `{"event":"GROUP_UPDATED","id":"i-0123456789abcdef0"}`),
+				),
+			},
+			{
+				Config: createSubscriptionTerraform(testSubscription_Endpoints_Update, subscriptionName, groupResourceId, groupTerraform),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckElastigroupExists(&group, groupResourceName),
+					testCheckElastigroupAttributes(&group, groupName),
+
+					testCheckSubscriptionExists(&sub, subResourceName),
+					resource.TestCheckResourceAttr(subResourceName, "event_type", "GROUP_ROLL_FINISHED"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoints.0.email_json.0.address", "test.update@me.com"),
+					resource.TestCheckResourceAttr(subResourceName, "protocol", "email-json"),
+					resource.TestCheckResourceAttr(subResourceName, "endpoint", "test.update@me.com"),
+				),
+			},
+			{
+				ResourceName:            subResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoints"},
+			},
+		},
+	})
+}
+
+const testSubscription_Endpoints_Create = `
+resource "` + string(commons.SubscriptionResourceName) + `" "%v" {
+  provider = "aws"
+  resource_id="%v"
+  event_type="GROUP_UPDATED"
+
+  format = {
+		event = "%%event%%"
+		id    = "%%instance-id%%"
+  }
+
+  endpoints {
+    email {
+      address = "test@me.com"
+    }
+  }
+
+  endpoints {
+    web {
+      url = "https://test.me"
+    }
+  }
+}
+`
+
+const testSubscription_Endpoints_Update = `
+resource "` + string(commons.SubscriptionResourceName) + `" "%v" {
+  provider = "aws"
+  resource_id="%v"
+  event_type="GROUP_ROLL_FINISHED"
+
+  format = {
+		event = "%%event%%"
+		id    = "%%instance-id%%"
+  }
+
+  endpoints {
+    email_json {
+      address = "test.update@me.com"
+    }
+  }
+}
+`
+
+// endregion
@@ -12,4 +12,127 @@ const (
 	Protocol   commons.FieldName = "protocol"
 	Endpoint   commons.FieldName = "endpoint"
 	Format     commons.FieldName = "format"
+
+	Endpoints      commons.FieldName = "endpoints"
+	SubscriptionID commons.FieldName = "subscription_id"
+	Email          commons.FieldName = "email"
+	EmailJSON      commons.FieldName = "email_json"
+	AWSSNS         commons.FieldName = "aws_sns"
+	Web            commons.FieldName = "web"
+	Address        commons.FieldName = "address"
+	TopicARN       commons.FieldName = "topic_arn"
+	URL            commons.FieldName = "url"
+
+	FormatPreview commons.FieldName = "format_preview"
 )
+
+// eventCatalog lists the events that can be subscribed to for the resources
+// whose IDs start with prefix.
+type eventCatalog struct {
+	resourceType string
+	prefix       string
+	eventTypes   []string
+}
+
+var eventCatalogs = []eventCatalog{
+	{
+		resourceType: "Elastigroup",
+		prefix:       "sig-",
+		eventTypes: []string{
+			"AWS_EC2_INSTANCE_TERMINATE",
+			"AWS_EC2_INSTANCE_TERMINATED",
+			"AWS_EC2_INSTANCE_LAUNCH",
+			"AWS_EC2_INSTANCE_READY_SIGNAL_TIMEOUT",
+			"SIGNAL_TIMEOUT_SHUTDOWN_SCRIPT",
+			"AWS_EC2_CANT_SPIN_OD",
+			"AWS_EC2_INSTANCE_UNHEALTHY_IN_ELB",
+			"GROUP_ROLL_FAILED",
+			"GROUP_ROLL_FINISHED",
+			"CANT_SCALE_UP_GROUP_MAX_CAPACITY",
+			"GROUP_UPDATED",
+			"AWS_EMR_PROVISION_TIMEOUT",
+			"GROUP_BEANSTALK_INIT_READY",
+			"AZURE_VM_TERMINATED",
+			"AZURE_VM_TERMINATE",
+		},
+	},
+	{
+		resourceType: "Ocean",
+		prefix:       "o-",
+		eventTypes: []string{
+			"CLUSTER_ROLL_FINISHED",
+			"GROUP_ROLL_FAILED",
+			"OCEAN_CANT_SCALE_UP_MAX_RESOURCES",
+			"OCEAN_LAUNCH_SPEC_CANT_SCALE_UP_MAX_INSTANCES",
+			"OCEAN_K8S_NODE_REMOVED",
+		},
+	},
+	{
+		resourceType: "managed instance",
+		prefix:       "smi-",
+		eventTypes: []string{
+			"AWS_EC2_INSTANCE_TERMINATE",
+			"AWS_EC2_INSTANCE_TERMINATED",
+			"AWS_EC2_INSTANCE_LAUNCH",
+			"AWS_EC2_INSTANCE_READY_SIGNAL_TIMEOUT",
+			"AWS_EC2_INSTANCE_UNHEALTHY_IN_ELB",
+			"AWS_EC2_MANAGED_INSTANCE_PAUSING",
+			"AWS_EC2_MANAGED_INSTANCE_RESUMING",
+			"AWS_EC2_MANAGED_INSTANCE_RECYCLING",
+			"AWS_EC2_MANAGED_INSTANCE_DELETING",
+		},
+	},
+}
+
+// eventTypes returns the events of all the catalogs.
+func eventTypes() []string {
+	var types []string
+	seen := make(map[string]bool)
+	for _, catalog := range eventCatalogs {
+		for _, eventType := range catalog.eventTypes {
+			if !seen[eventType] {
+				seen[eventType] = true
+				types = append(types, eventType)
+			}
+		}
+	}
+	return types
+}
+
+// protocols are the supported notification protocols; http and https are
+// deprecated in favor of web.
+var protocols = []string{"email", "email-json", "aws-sns", "web", "http", "https"}
+
+// endpointProtocols maps the protocol-specific blocks of endpoints to the
+// protocol they subscribe with and the attribute holding the endpoint.
+var endpointProtocols = []struct {
+	block     commons.FieldName
+	attribute commons.FieldName
+	protocol  string
+}{
+	{Email, Address, "email"},
+	{EmailJSON, Address, "email-json"},
+	{AWSSNS, TopicARN, "aws-sns"},
+	{Web, URL, "web"},
+}
+
+// defaultFormat is the notification content sent when no format is set.
+var defaultFormat = map[string]interface{}{
+	"event":        "%event%",
+	"instanceId":   "%instance-id%",
+	"resourceId":   "%resource-id%",
+	"resourceName": "%resource-name%",
+}
+
+// formatPreviewValues are the example values the format variables are
+// rendered with in format_preview. %event% and %resource-id% are rendered
+// with the subscription's own values.
+var formatPreviewValues = map[string]string{
+	"instance-id":       "i-0123456789abcdef0",
+	"resource-name":     "example-resource",
+	"subnet-id":         "subnet-0123456789abcdef0",
+	"availability-zone": "us-east-1a",
+	"reason":            "example reason",
+	"private-ip":        "10.0.0.10",
+	"launchspec-id":     "ols-0123456789",
+}
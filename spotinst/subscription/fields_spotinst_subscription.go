@@ -1,10 +1,14 @@
 package subscription
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spotinst/spotinst-sdk-go/service/subscription"
 	"github.com/spotinst/spotinst-sdk-go/spotinst"
 	"github.com/spotinst/terraform-provider-spotinst/spotinst/commons"
@@ -47,8 +51,9 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.Subscription,
 		EventType,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(eventTypes(), true),
 			StateFunc: func(v interface{}) string {
 				value := v.(string)
 				return strings.ToUpper(value)
@@ -82,8 +87,12 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.Subscription,
 		Protocol,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{string(Endpoints)},
+			RequiredWith:  []string{string(Endpoint)},
+			ValidateFunc:  validation.StringInSlice(protocols, false),
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
@@ -113,8 +122,11 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		commons.Subscription,
 		Endpoint,
 		&schema.Schema{
-			Type:     schema.TypeString,
-			Required: true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{string(Endpoint), string(Endpoints)},
+			RequiredWith: []string{string(Protocol)},
 		},
 		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
 			sub := resourceObject.(*subscription.Subscription)
@@ -170,4 +182,259 @@ func Setup(fieldsMap map[commons.FieldName]*commons.GenericField) {
 		},
 		nil,
 	)
+
+	fieldsMap[Endpoints] = commons.NewGenericField(
+		commons.Subscription,
+		Endpoints,
+		&schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					string(Email): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(Address): {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringMatch(emailRegex, "expected an email address"),
+								},
+							},
+						},
+					},
+
+					string(EmailJSON): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(Address): {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringMatch(emailRegex, "expected an email address"),
+								},
+							},
+						},
+					},
+
+					string(AWSSNS): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(TopicARN): {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: commons.ValidateARN,
+								},
+							},
+						},
+					},
+
+					string(Web): {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								string(URL): {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+								},
+							},
+						},
+					},
+
+					string(SubscriptionID): {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		// Every endpoint is a subscription of its own, which the resource
+		// creates, reads and deletes.
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	fieldsMap[FormatPreview] = commons.NewGenericField(
+		commons.Subscription,
+		FormatPreview,
+		&schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		func(resourceObject interface{}, resourceData *schema.ResourceData, meta interface{}) error {
+			sub := resourceObject.(*subscription.Subscription)
+			preview, err := RenderFormat(sub.Format, spotinst.StringValue(sub.EventType), spotinst.StringValue(sub.ResourceID))
+			if err != nil {
+				return err
+			}
+			if err := resourceData.Set(string(FormatPreview), preview); err != nil {
+				return fmt.Errorf(string(commons.FailureFieldReadPattern), string(FormatPreview), err)
+			}
+			return nil
+		},
+		nil,
+		nil,
+		nil,
+	)
+}
+
+var emailRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// NotificationEndpoint is a protocol and endpoint pair of the endpoints list, along with
+// the ID of the subscription created for it.
+type NotificationEndpoint struct {
+	SubscriptionID string
+	Protocol       string
+	Address        string
+}
+
+// ExpandEndpoints expands the endpoints list. Each endpoint must set exactly
+// one protocol block.
+func ExpandEndpoints(data interface{}) ([]*NotificationEndpoint, error) {
+	list, _ := data.([]interface{})
+	endpoints := make([]*NotificationEndpoint, 0, len(list))
+	for i, item := range list {
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.%d: exactly one of %s must be set", Endpoints, i, endpointBlockNames())
+		}
+
+		endpoint := &NotificationEndpoint{}
+		if v, ok := m[string(SubscriptionID)].(string); ok {
+			endpoint.SubscriptionID = v
+		}
+
+		count := 0
+		for _, p := range endpointProtocols {
+			blocks, _ := m[string(p.block)].([]interface{})
+			if len(blocks) == 0 {
+				continue
+			}
+			count++
+			endpoint.Protocol = p.protocol
+			if block, ok := blocks[0].(map[string]interface{}); ok {
+				endpoint.Address, _ = block[string(p.attribute)].(string)
+			}
+		}
+		if count != 1 {
+			return nil, fmt.Errorf("%s.%d: exactly one of %s must be set", Endpoints, i, endpointBlockNames())
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+	return endpoints, nil
+}
+
+// FlattenEndpoints flattens endpoints into the endpoints list. The
+// deprecated http and https protocols are flattened into the web block.
+func FlattenEndpoints(endpoints []*NotificationEndpoint) []interface{} {
+	result := make([]interface{}, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		m := map[string]interface{}{
+			string(SubscriptionID): endpoint.SubscriptionID,
+		}
+
+		protocol := endpoint.Protocol
+		if protocol == "http" || protocol == "https" {
+			protocol = "web"
+		}
+		for _, p := range endpointProtocols {
+			if p.protocol == protocol {
+				m[string(p.block)] = []interface{}{
+					map[string]interface{}{
+						string(p.attribute): endpoint.Address,
+					},
+				}
+			}
+		}
+
+		result = append(result, m)
+	}
+	return result
+}
+
+func endpointBlockNames() string {
+	names := make([]string, 0, len(endpointProtocols))
+	for _, p := range endpointProtocols {
+		names = append(names, string(p.block))
+	}
+	return strings.Join(names, ", ")
+}
+
+// RenderFormat renders the notification content of format, or of the
+// default format when it is empty, with example values for the variables.
+func RenderFormat(format map[string]interface{}, eventType, resourceID string) (string, error) {
+	if len(format) == 0 {
+		format = defaultFormat
+	}
+
+	values := map[string]string{
+		"event":       eventType,
+		"resource-id": resourceID,
+	}
+	for k, v := range formatPreviewValues {
+		values[k] = v
+	}
+
+	rendered := make(map[string]string, len(format))
+	for k, v := range format {
+		content := fmt.Sprint(v)
+		for name, value := range values {
+			content = strings.ReplaceAll(content, "%"+name+"%", value)
+		}
+		rendered[k] = content
+	}
+
+	preview, err := json.Marshal(rendered)
+	if err != nil {
+		return "", err
+	}
+	return string(preview), nil
+}
+
+// CustomizeDiff validates event_type against the event catalog of the
+// resource type, validates the endpoints list and computes format_preview.
+func CustomizeDiff(diff *schema.ResourceDiff) error {
+	known := diff.NewValueKnown(string(ResourceId)) && diff.NewValueKnown(string(EventType))
+	resourceID := diff.Get(string(ResourceId)).(string)
+	eventType := strings.ToUpper(diff.Get(string(EventType)).(string))
+
+	if known {
+		for _, catalog := range eventCatalogs {
+			if strings.HasPrefix(resourceID, catalog.prefix) && !slices.Contains(catalog.eventTypes, eventType) {
+				return fmt.Errorf("%s: %q is not an event of %s resources, expected one of: %s",
+					EventType, eventType, catalog.resourceType, strings.Join(catalog.eventTypes, ", "))
+			}
+		}
+	}
+
+	if _, err := ExpandEndpoints(diff.Get(string(Endpoints))); err != nil {
+		return err
+	}
+
+	if !known || !diff.NewValueKnown(string(Format)) {
+		return diff.SetNewComputed(string(FormatPreview))
+	}
+	format, _ := diff.Get(string(Format)).(map[string]interface{})
+	preview, err := RenderFormat(format, eventType, resourceID)
+	if err != nil {
+		return err
+	}
+	if preview != diff.Get(string(FormatPreview)).(string) {
+		return diff.SetNew(string(FormatPreview), preview)
+	}
+	return nil
 }